@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"os"
+	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/spf13/cobra"
@@ -14,6 +15,10 @@ var (
 	rootEnvVar  string
 	rootLogFile string
 
+	// Tool options
+	rootMaxSnoozeDuration time.Duration
+	rootEnableDeleteAlert bool
+
 	// Transport options
 	rootTransport       string
 	rootHttpAddr        string
@@ -56,7 +61,7 @@ func Execute() {
 	// Check if no subcommand was provided and run serve logic (backwards compatibility)
 	if len(os.Args) == 1 {
 		// Run serve logic directly with root command flag values
-		err := runServeWithVersion(rootApiURL, rootEnvVar, rootLogFile, rootTransport, rootHttpAddr, rootSseEndpoint, rootMessageEndpoint, rootHttpEndpoint, rootCmd.Version)
+		err := runServeWithVersion(rootApiURL, rootEnvVar, rootLogFile, rootMaxSnoozeDuration, rootEnableDeleteAlert, rootTransport, rootHttpAddr, rootSseEndpoint, rootMessageEndpoint, rootHttpEndpoint, rootCmd.Version)
 		if err != nil {
 			os.Exit(1)
 		}
@@ -84,6 +89,10 @@ func init() {
 	rootCmd.Flags().StringVar(&rootEnvVar, "token-env-var", "OPSGENIE_TOKEN", "Name of environment variable containing your OpsGenie API token")
 	rootCmd.Flags().StringVar(&rootLogFile, "log-file", "", "Path to log file (logs is disabled if not specified)")
 
+	// Tool flags
+	rootCmd.Flags().DurationVar(&rootMaxSnoozeDuration, "max-snooze-duration", 7*24*time.Hour, "Maximum duration an alert can be snoozed for")
+	rootCmd.Flags().BoolVar(&rootEnableDeleteAlert, "enable-delete-alert", false, "Register the delete_alert tool, which permanently deletes alerts")
+
 	// Transport flags
 	rootCmd.Flags().StringVar(&rootTransport, "transport", "stdio", "Transport type: stdio, sse, or streamable-http")
 	rootCmd.Flags().StringVar(&rootHttpAddr, "http-addr", ":8080", "HTTP server address (for sse and streamable-http transports)")
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
@@ -24,6 +25,10 @@ func newServeCmd() *cobra.Command {
 		envVar  string
 		logFile string
 
+		// Tool options
+		maxSnoozeDuration time.Duration
+		enableDeleteAlert bool
+
 		// Transport options
 		transport       string
 		httpAddr        string
@@ -45,7 +50,7 @@ Supports multiple transport types:
 
 The server requires an OpsGenie API token to authenticate with the service.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runServeWithVersion(apiURL, envVar, logFile, transport, httpAddr, sseEndpoint, messageEndpoint, httpEndpoint, cmd.Root().Version)
+			return runServeWithVersion(apiURL, envVar, logFile, maxSnoozeDuration, enableDeleteAlert, transport, httpAddr, sseEndpoint, messageEndpoint, httpEndpoint, cmd.Root().Version)
 		},
 	}
 
@@ -54,6 +59,10 @@ The server requires an OpsGenie API token to authenticate with the service.`,
 	cmd.Flags().StringVar(&envVar, "token-env-var", "OPSGENIE_TOKEN", "Name of environment variable containing your OpsGenie API token")
 	cmd.Flags().StringVar(&logFile, "log-file", "", "Path to log file (logs is disabled if not specified)")
 
+	// Tool flags
+	cmd.Flags().DurationVar(&maxSnoozeDuration, "max-snooze-duration", 7*24*time.Hour, "Maximum duration an alert can be snoozed for")
+	cmd.Flags().BoolVar(&enableDeleteAlert, "enable-delete-alert", false, "Register the delete_alert tool, which permanently deletes alerts")
+
 	// Transport flags
 	cmd.Flags().StringVar(&transport, "transport", "stdio", "Transport type: stdio, sse, or streamable-http")
 	cmd.Flags().StringVar(&httpAddr, "http-addr", ":8080", "HTTP server address (for sse and streamable-http transports)")
@@ -65,7 +74,7 @@ The server requires an OpsGenie API token to authenticate with the service.`,
 }
 
 // runServeWithVersion contains the main server logic with support for multiple transports and explicit version
-func runServeWithVersion(apiURL, envVar, logFile, transport, httpAddr, sseEndpoint, messageEndpoint, httpEndpoint, version string) error {
+func runServeWithVersion(apiURL, envVar, logFile string, maxSnoozeDuration time.Duration, enableDeleteAlert bool, transport, httpAddr, sseEndpoint, messageEndpoint, httpEndpoint, version string) error {
 	// Setup graceful shutdown - listen for both SIGINT and SIGTERM
 	shutdownCtx, cancel := signal.NotifyContext(context.Background(),
 		os.Interrupt, syscall.SIGTERM)
@@ -97,7 +106,7 @@ func runServeWithVersion(apiURL, envVar, logFile, transport, httpAddr, sseEndpoi
 	)
 
 	// Register the OpsGenie handler with the MCP server
-	err := mcp.RegisterOpsGenieHandler(mcpSrv, apiURL, envVar)
+	err := mcp.RegisterOpsGenieHandler(mcpSrv, apiURL, envVar, maxSnoozeDuration, enableDeleteAlert)
 	if err != nil {
 		return err
 	}
@@ -6,9 +6,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
 )
 
 // listAlertQueryDescription contains comprehensive documentation for OpsGenie alert search queries.
@@ -35,6 +39,7 @@ You can search using field:value combinations with most alert fields:
 | source | john.smith@opsgenie.com | Alert source |
 | entity | entity1 | Related entity |
 | status | open | Alert status (open or closed) |
+| priority | P1 | Alert priority (P1 to P5) |
 | owner | john.smith@opsgenie.com | Alert owner username |
 | acknowledgedBy | john.smith@opsgenie.com | Who acknowledged the alert |
 | closedBy | john.smith@opsgenie.com | Who closed the alert |
@@ -113,13 +118,65 @@ source, entity, tag, actions, owner, teams, acknowledgedBy, closedBy, recipients
 5. Remember that wildcards only work at the end of words
 6. Status field only accepts "open" or "closed" as values`
 
+const (
+	// defaultAlertsPageSize is the number of alerts returned by 'list_alerts' if no limit is given.
+	defaultAlertsPageSize = 20
+
+	// maxAlertsPageSize is the maximum number of alerts returned by a single 'list_alerts' call.
+	// This limit is enforced by the OpsGenie API.
+	maxAlertsPageSize = 100
+
+	// maxAlertsOffset is the maximum offset plus limit of a 'list_alerts' page.
+	// This limit is enforced by the OpsGenie API.
+	maxAlertsOffset = 20000
+)
+
+// listAlertsResult is the response of the 'list_alerts' tool.
+// Alerts are either complete or restricted to the fields selected with the 'fields' argument.
+type listAlertsResult struct {
+	Alerts     []any  `json:"alerts"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
 func (h *opsgenieHandler) registerAlertTools(s *server.MCPServer) {
 	// Define the list_alerts tooListAlertsmprehensive documentation
 	tool := mcp.NewTool("list_alerts",
-		mcp.WithDescription("Retrieve a list of alerts from OpsGenie"),
+		mcp.WithDescription("Retrieve a page of alerts from OpsGenie, most recent first unless 'sort' or 'order' say otherwise. If more alerts match, the response contains a 'next_cursor' to fetch the next page."),
 		mcp.WithString("query",
 			mcp.Description(listAlertQueryDescription),
 		),
+		mcp.WithString("saved_search",
+			mcp.Description("Optional name or ID of a saved search whose query is used to filter the alerts (see 'list_saved_searches'). Cannot be combined with 'query'."),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description(fmt.Sprintf("Maximum number of alerts to return, between 1 and %d. Defaults to %d.", maxAlertsPageSize, defaultAlertsPageSize)),
+			mcp.Min(1),
+			mcp.Max(maxAlertsPageSize),
+		),
+		mcp.WithNumber("offset",
+			mcp.Description("Number of matching alerts to skip. Defaults to 0."),
+			mcp.Min(0),
+		),
+		mcp.WithString("cursor",
+			mcp.Description("Opaque cursor returned as 'next_cursor' by a previous call, to fetch the next page of the same search. Cannot be combined with 'query', 'saved_search', 'priority', 'since', 'until', 'sort', 'order' or 'offset'."),
+		),
+		mcp.WithString("priority",
+			mcp.Description("Optional priority, or comma-separated list of priorities (e.g. \"P1,P2\"), that the alerts must have. It is combined with the query using AND."),
+		),
+		withTimeWindow(),
+		mcp.WithString("sort",
+			mcp.Description(fmt.Sprintf("Optional field to sort the alerts by. Defaults to 'createdAt'. Possible values are: %s.", strings.Join(alertSortFields, ", "))),
+			mcp.Enum(alertSortFields...),
+		),
+		mcp.WithString("order",
+			mcp.Description("Optional sorting order of the alerts. Defaults to 'desc', e.g. most recent first when sorting by 'createdAt'."),
+			mcp.Enum(string(alert.Asc), string(alert.Desc)),
+		),
+		mcp.WithBoolean("fetch_all",
+			mcp.Description("If true, fetch all matching alerts (up to 20000) instead of a single page. This is slow and returns a lot of data, so only use it when really needed. Cannot be combined with 'limit', 'offset' or 'cursor'."),
+		),
+		withAlertFields(listedAlertFields),
+		withAlertFormat(),
 
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithDestructiveHintAnnotation(false),
@@ -127,12 +184,45 @@ func (h *opsgenieHandler) registerAlertTools(s *server.MCPServer) {
 	)
 	s.AddTool(tool, h.ListAlerts)
 
+	countAlertsTool := mcp.NewTool("count_alerts",
+		mcp.WithDescription("Counts the alerts in OpsGenie matching a search query, without retrieving them. Multiple named queries can be counted in one call with 'queries'."),
+		mcp.WithString("query",
+			mcp.Description("Search query for filtering alerts, using the same syntax as 'list_alerts'. Defaults to \"status:open\" if neither 'query' nor 'queries' is provided."),
+		),
+		mcp.WithArray("queries",
+			mcp.Description("Optional list of named queries to count in one call, e.g. [{\"name\": \"open P1\", \"query\": \"status:open AND priority:P1\"}]. Cannot be combined with 'query'."),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Name identifying the query in the response.",
+					},
+					"query": map[string]any{
+						"type":        "string",
+						"description": "Search query for filtering alerts.",
+					},
+				},
+				"required": []string{"name", "query"},
+			}),
+		),
+		withTimeWindow(),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(countAlertsTool, h.CountAlerts)
+
 	getAlertTool := mcp.NewTool("get_alert",
 		mcp.WithDescription("Retrieves a single alert from OpsGenie using its ID, alias, or tiny ID."),
 		mcp.WithString("id",
-			mcp.Description("Alert id of the alert to be retrieved."),
+			mcp.Description("Identifier of the alert to be retrieved."),
 			mcp.Required(),
 		),
+		withAlertIdentifierType(),
+		withAlertFields(alertFields),
+		withAlertFormat(),
 
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithDestructiveHintAnnotation(false),
@@ -143,9 +233,10 @@ func (h *opsgenieHandler) registerAlertTools(s *server.MCPServer) {
 	acknowledgeAlertTool := mcp.NewTool("acknowledge_alert",
 		mcp.WithDescription("Acknowledges an alert in OpsGenie."),
 		mcp.WithString("id",
-			mcp.Description("Alert id of the alert to acknowledge."),
+			mcp.Description("Identifier of the alert to acknowledge."),
 			mcp.Required(),
 		),
+		withAlertIdentifierType(),
 		mcp.WithString("note",
 			mcp.Description("Optional note to add to the alert."),
 		),
@@ -155,6 +246,7 @@ func (h *opsgenieHandler) registerAlertTools(s *server.MCPServer) {
 		mcp.WithString("source",
 			mcp.Description("Optional display name of the request source."),
 		),
+		withWait(),
 
 		mcp.WithReadOnlyHintAnnotation(false),
 		mcp.WithDestructiveHintAnnotation(false),
@@ -165,9 +257,10 @@ func (h *opsgenieHandler) registerAlertTools(s *server.MCPServer) {
 	unacknowledgeAlertTool := mcp.NewTool("unacknowledge_alert",
 		mcp.WithDescription("Unacknowledges an alert in OpsGenie."),
 		mcp.WithString("id",
-			mcp.Description("Alert id of the alert to unacknowledge."),
+			mcp.Description("Identifier of the alert to unacknowledge."),
 			mcp.Required(),
 		),
+		withAlertIdentifierType(),
 		mcp.WithString("note",
 			mcp.Description("Optional note to add to the alert."),
 		),
@@ -177,129 +270,1753 @@ func (h *opsgenieHandler) registerAlertTools(s *server.MCPServer) {
 		mcp.WithString("source",
 			mcp.Description("Optional display name of the request source."),
 		),
+		withWait(),
 
 		mcp.WithReadOnlyHintAnnotation(false),
 		mcp.WithDestructiveHintAnnotation(false),
 		mcp.WithOpenWorldHintAnnotation(true),
 	)
 	s.AddTool(unacknowledgeAlertTool, h.UnacknowledgeAlert)
-}
 
-// ListAlerts retrieves alerts from OpsGenie based on the provided search query.
-// This method implements the MCP tool handler interface for the 'list_alerts' tool.
-//
-// Parameters:
-//   - ctx: The context for the request, used for cancellation and timeouts
-//   - request: The MCP tool call request containing the search query parameter
-//
-// Returns:
-//   - A CallToolResult containing the serialized alerts data on success
-//   - A CallToolResult with error information on failure
-//   - An error is only returned for internal MCP framework issues (always nil in this implementation)
-func (h *opsgenieHandler) ListAlerts(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Extract the query parameter (defaults to status:open if not provided)
-	query := request.GetString("query", "status:open")
+	closeAlertTool := mcp.NewTool("close_alert",
+		mcp.WithDescription("Closes an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to close."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
 
-	// Fetch alerts from OpsGenie using the provided query
-	alerts, err := h.alertClient.ListAlerts(ctx, query)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alerts from OpsGenie: %v", err)), nil
-	}
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(closeAlertTool, h.CloseAlert)
 
-	// Serialize the alerts to JSON for the MCP response
-	data, err := json.Marshal(alerts)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize alerts to JSON: %v", err)), nil
-	}
+	createAlertTool := mcp.NewTool("create_alert",
+		mcp.WithDescription("Creates a new alert in OpsGenie. Alerts with the same alias as an open alert are deduplicated by OpsGenie."),
+		mcp.WithString("message",
+			mcp.Description("Message of the alert. Limited to 130 characters."),
+			mcp.Required(),
+		),
+		mcp.WithString("alias",
+			mcp.Description("Optional client-defined identifier of the alert, used for deduplication. Repeated calls with the same alias increase the count of the open alert instead of creating a new one."),
+		),
+		mcp.WithString("description",
+			mcp.Description("Optional description of the alert."),
+		),
+		mcp.WithArray("responders",
+			mcp.Description("Optional teams, users, escalations and schedules that the alert will be routed to."),
+			mcp.Items(responderSchema),
+		),
+		mcp.WithArray("visible_to",
+			mcp.Description("Optional teams and users that the alert will become visible to without sending any notification."),
+			mcp.Items(responderSchema),
+		),
+		mcp.WithArray("actions",
+			mcp.Description("Optional custom actions that will be available for the alert."),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("tags",
+			mcp.Description("Optional tags of the alert."),
+			mcp.WithStringItems(),
+		),
+		mcp.WithObject("details",
+			mcp.Description("Optional map of key-value pairs to use as custom properties of the alert."),
+			mcp.AdditionalProperties(map[string]any{"type": "string"}),
+		),
+		mcp.WithString("entity",
+			mcp.Description("Optional entity field of the alert, generally used to specify which domain the alert is related to."),
+		),
+		mcp.WithString("priority",
+			mcp.Description("Optional priority level of the alert. Defaults to P3."),
+			mcp.Enum(alertPriorities...),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
 
-	// Return the serialized alerts as a text result
-	return mcp.NewToolResultText(string(data)), nil
-}
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(createAlertTool, h.CreateAlert)
 
-// GetAlert retrieves a single OpsGenie alert by its ID.
-// This method implements the MCP tool handler interface for the 'get_alert' tool.
-//
-// Parameters:
-//   - ctx: The context for the request, used for cancellation and timeouts
-//   - request: The MCP tool call request containing the alert ID parameter
-//
-// Returns:
-//   - A CallToolResult containing the serialized alert data on success
-//   - A CallToolResult with error information on failure
-//   - An error is only returned for internal MCP framework issues (always nil in this implementation)
-func (h *opsgenieHandler) GetAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Extract the alert ID parameter
-	id := request.GetString("id", "")
-	if id == "" {
-		return mcp.NewToolResultError("the 'id' parameter is required"), nil
-	}
+	snoozeAlertTool := mcp.NewTool("snooze_alert",
+		mcp.WithDescription(fmt.Sprintf("Snoozes an alert in OpsGenie until a given time. Either 'end_time' or 'duration' must be provided. Alerts can be snoozed for at most %s.", h.maxSnoozeDuration)),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to snooze."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("end_time",
+			mcp.Description("Absolute time until which the alert is snoozed, in RFC3339 format (e.g. 2024-05-01T15:04:05Z)."),
+		),
+		mcp.WithString("duration",
+			mcp.Description("Relative duration for which the alert is snoozed (e.g. 45m, 2h30m)."),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
 
-	// Fetch the alert from OpsGenie
-	alert, err := h.alertClient.GetAlert(ctx, id)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alert with ID '%s' from OpsGenie: %v", id, err)), nil
-	}
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(snoozeAlertTool, h.SnoozeAlert)
 
-	// Serialize the alert to JSON for the MCP response
-	data, err := json.Marshal(alert)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize alert to JSON: %v", err)), nil
-	}
+	addAlertNoteTool := mcp.NewTool("add_alert_note",
+		mcp.WithDescription("Adds a note to an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to add the note to."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("note",
+			mcp.Description("Note to add to the alert."),
+			mcp.Required(),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
 
-	// Return the serialized alert as a text result
-	return mcp.NewToolResultText(string(data)), nil
-}
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(addAlertNoteTool, h.AddAlertNote)
 
-// AcknowledgeAlert acknowledges an OpsGenie alert.
-// This method implements the MCP tool handler interface for the 'acknowledge_alert' tool.
-func (h *opsgenieHandler) AcknowledgeAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Extract parameters
-	id := request.GetString("id", "")
-	if id == "" {
-		return mcp.NewToolResultError("the 'id' parameter is required"), nil
-	}
-	note := request.GetString("note", "")
-	user := request.GetString("user", "")
-	source := request.GetString("source", "mcp-opsgenie")
+	listAlertNotesTool := mcp.NewTool("list_alert_notes",
+		mcp.WithDescription("Retrieves a page of notes of an alert from OpsGenie. Use the offsets in the returned 'paging' field to fetch further pages."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to list the notes of."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("offset",
+			mcp.Description("Optional offset to start the page from, as returned in the 'paging' field of a previous call."),
+		),
+		mcp.WithString("direction",
+			mcp.Description("Optional page direction relative to the offset. Defaults to 'next'."),
+			mcp.Enum(string(alert.NEXT), string(alert.PREV)),
+		),
+		mcp.WithString("order",
+			mcp.Description("Optional sorting order of the notes by creation time. Defaults to 'desc'."),
+			mcp.Enum(string(alert.Asc), string(alert.Desc)),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Optional maximum number of notes to return. Defaults to 100."),
+			mcp.Min(1),
+			mcp.Max(100),
+		),
 
-	// Acknowledge the alert
-	result, err := h.alertClient.AcknowledgeAlert(ctx, id, user, note, source)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to acknowledge alert with ID '%s': %v", id, err)), nil
-	}
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(listAlertNotesTool, h.ListAlertNotes)
 
-	// Serialize the result to JSON
-	data, err := json.Marshal(result)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
-	}
+	listAlertLogsTool := mcp.NewTool("list_alert_logs",
+		mcp.WithDescription("Retrieves the activity log of an alert from OpsGenie, such as notifications, acknowledgements and executed rules. Logs longer than 10000 entries are truncated, which is reported with a warning."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to list the logs of."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("order",
+			mcp.Description("Optional sorting order of the log entries by creation time. Defaults to 'asc' (oldest first)."),
+			mcp.Enum(string(alert.Asc), string(alert.Desc)),
+		),
 
-	return mcp.NewToolResultText(string(data)), nil
-}
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(listAlertLogsTool, h.ListAlertLogs)
 
-// UnacknowledgeAlert unacknowledges an OpsGenie alert.
-// This method implements the MCP tool handler interface for the 'unacknowledge_alert' tool.
-func (h *opsgenieHandler) UnacknowledgeAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Extract parameters
-	id := request.GetString("id", "")
-	if id == "" {
-		return mcp.NewToolResultError("the 'id' parameter is required"), nil
-	}
-	note := request.GetString("note", "")
-	user := request.GetString("user", "")
-	source := request.GetString("source", "mcp-opsgenie")
+	listAlertRecipientsTool := mcp.NewTool("list_alert_recipients",
+		mcp.WithDescription("Retrieves the recipients of an alert from OpsGenie with their notification state (e.g. notified, acknowledged), notification method and timestamps, preceded by a count of recipients per state."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to list the recipients of."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
 
-	// Unacknowledge the alert
-	result, err := h.alertClient.UnacknowledgeAlert(ctx, id, user, note, source)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to unacknowledge alert with ID '%s': %v", id, err)), nil
-	}
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(listAlertRecipientsTool, h.ListAlertRecipients)
 
-	// Serialize the result to JSON
-	data, err := json.Marshal(result)
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
-	}
+	addAlertTagsTool := mcp.NewTool("add_alert_tags",
+		mcp.WithDescription("Adds tags to an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to add the tags to."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithArray("tags",
+			mcp.Description("Tags to add to the alert."),
+			mcp.WithStringItems(),
+			mcp.MinItems(1),
+			mcp.Required(),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
 
-	return mcp.NewToolResultText(string(data)), nil
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(addAlertTagsTool, h.AddAlertTags)
+
+	removeAlertTagsTool := mcp.NewTool("remove_alert_tags",
+		mcp.WithDescription("Removes tags from an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to remove the tags from."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithArray("tags",
+			mcp.Description("Tags to remove from the alert."),
+			mcp.WithStringItems(),
+			mcp.MinItems(1),
+			mcp.Required(),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(removeAlertTagsTool, h.RemoveAlertTags)
+
+	addAlertDetailsTool := mcp.NewTool("add_alert_details",
+		mcp.WithDescription("Adds custom detail properties to an alert in OpsGenie. Existing keys are overwritten."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to add the details to."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithObject("details",
+			mcp.Description("Map of key-value pairs to add to the alert (e.g. {\"cluster\": \"prod-1\"})."),
+			mcp.AdditionalProperties(map[string]any{"type": "string"}),
+			mcp.MinProperties(1),
+			mcp.Required(),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(addAlertDetailsTool, h.AddAlertDetails)
+
+	removeAlertDetailsTool := mcp.NewTool("remove_alert_details",
+		mcp.WithDescription("Removes custom detail properties from an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to remove the details from."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithArray("keys",
+			mcp.Description("Detail keys to remove from the alert."),
+			mcp.WithStringItems(),
+			mcp.MinItems(1),
+			mcp.Required(),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(removeAlertDetailsTool, h.RemoveAlertDetails)
+
+	assignAlertTool := mcp.NewTool("assign_alert",
+		mcp.WithDescription("Assigns an owner to an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to assign."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("owner",
+			mcp.Description("Username or ID of the user to assign as owner."),
+			mcp.Required(),
+		),
+		mcp.WithString("owner_type",
+			mcp.Description("Type of the owner identifier. Possible values are 'username' and 'id'. Defaults to 'username'."),
+			mcp.Enum("username", "id"),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(assignAlertTool, h.AssignAlert)
+
+	addTeamToAlertTool := mcp.NewTool("add_team_to_alert",
+		mcp.WithDescription("Adds a team to an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to add the team to."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("team",
+			mcp.Description("Name or ID of the team to add."),
+			mcp.Required(),
+		),
+		mcp.WithString("team_type",
+			mcp.Description("Type of the team identifier. Possible values are 'name' and 'id'. Defaults to 'name'."),
+			mcp.Enum("name", "id"),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(addTeamToAlertTool, h.AddTeamToAlert)
+
+	addResponderToAlertTool := mcp.NewTool("add_responder_to_alert",
+		mcp.WithDescription("Adds a user or team responder to an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to add the responder to."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("responder_type",
+			mcp.Description("Type of the responder. Possible values are 'user' and 'team'."),
+			mcp.Enum(string(alert.UserResponder), string(alert.TeamResponder)),
+			mcp.Required(),
+		),
+		mcp.WithString("responder",
+			mcp.Description("Username or ID of the user, or name or ID of the team."),
+			mcp.Required(),
+		),
+		mcp.WithString("responder_identifier_type",
+			mcp.Description("Type of the responder identifier. Possible values are 'name' (username for users) and 'id'. Defaults to 'name'."),
+			mcp.Enum("name", "id"),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(addResponderToAlertTool, h.AddResponderToAlert)
+
+	escalateAlertTool := mcp.NewTool("escalate_alert",
+		mcp.WithDescription("Escalates an alert in OpsGenie to the next level of an escalation policy. Use 'list_escalations' to find the available escalation policies."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to escalate."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("escalation",
+			mcp.Description("Name or ID of the escalation policy, as returned by 'list_escalations'."),
+			mcp.Required(),
+		),
+		mcp.WithString("escalation_type",
+			mcp.Description("Type of the escalation identifier. Possible values are 'name' and 'id'. Defaults to 'name'."),
+			mcp.Enum("name", "id"),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(escalateAlertTool, h.EscalateAlert)
+
+	executeAlertActionTool := mcp.NewTool("execute_alert_action",
+		mcp.WithDescription("Executes a custom action on an alert in OpsGenie. The action must be one of the actions listed in the 'actions' field of the alert."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to execute the action on."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("action",
+			mcp.Description("Name of the custom action to execute, as listed in the 'actions' field of the alert."),
+			mcp.Required(),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to the alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(executeAlertActionTool, h.ExecuteAlertAction)
+
+	updateAlertPriorityTool := mcp.NewTool("update_alert_priority",
+		mcp.WithDescription("Updates the priority of an alert in OpsGenie. The response includes the priority before and after the update."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to update."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("priority",
+			mcp.Description("New priority of the alert."),
+			mcp.Enum(alertPriorities...),
+			mcp.Required(),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(updateAlertPriorityTool, h.UpdateAlertPriority)
+
+	updateAlertMessageTool := mcp.NewTool("update_alert_message",
+		mcp.WithDescription("Updates the message of an alert in OpsGenie. The response includes the message before and after the update."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to update."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("message",
+			mcp.Description("New message of the alert. Limited to 130 characters."),
+			mcp.MaxLength(130),
+			mcp.Required(),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(updateAlertMessageTool, h.UpdateAlertMessage)
+
+	updateAlertDescriptionTool := mcp.NewTool("update_alert_description",
+		mcp.WithDescription("Updates the description of an alert in OpsGenie. The response includes the description before and after the update."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to update."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("description",
+			mcp.Description("New description of the alert."),
+			mcp.Required(),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(updateAlertDescriptionTool, h.UpdateAlertDescription)
+
+	// delete_alert permanently removes alerts and is only available when explicitly enabled
+	if h.enableDeleteAlert {
+		deleteAlertTool := mcp.NewTool("delete_alert",
+			mcp.WithDescription("Permanently deletes an alert from OpsGenie. This cannot be undone. The tiny ID of the alert must be passed as confirmation."),
+			mcp.WithString("id",
+				mcp.Description("Identifier of the alert to delete."),
+				mcp.Required(),
+			),
+			withAlertIdentifierType(),
+			mcp.WithString("confirm_tiny_id",
+				mcp.Description("Tiny ID of the alert to delete, as returned by 'get_alert'. The alert is only deleted if it matches."),
+				mcp.Required(),
+			),
+			mcp.WithString("source",
+				mcp.Description("Optional display name of the request source."),
+			),
+			withWait(),
+
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithOpenWorldHintAnnotation(true),
+		)
+		s.AddTool(deleteAlertTool, h.DeleteAlert)
+	}
+}
+
+// ListAlerts retrieves alerts from OpsGenie based on the provided search query.
+// This method implements the MCP tool handler interface for the 'list_alerts' tool.
+//
+// Parameters:
+//   - ctx: The context for the request, used for cancellation and timeouts
+//   - request: The MCP tool call request containing the search query or saved search and pagination parameters
+//
+// Returns:
+//   - A CallToolResult containing the serialized page of alerts and the cursor of the next page on success
+//   - A CallToolResult with error information on failure
+//   - An error is only returned for internal MCP framework issues (always nil in this implementation)
+func (h *opsgenieHandler) ListAlerts(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	query := request.GetString("query", "")
+	savedSearch := request.GetString("saved_search", "")
+	priority := request.GetString("priority", "")
+	sortArg := request.GetString("sort", "")
+	orderArg := request.GetString("order", "")
+	cursorArg := request.GetString("cursor", "")
+	fetchAll := request.GetBool("fetch_all", false)
+	arguments := request.GetArguments()
+	_, hasLimit := arguments["limit"]
+	_, hasOffset := arguments["offset"]
+	window, err := getTimeWindow(request, time.Now())
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	switch {
+	case query != "" && savedSearch != "":
+		return mcp.NewToolResultError("the 'query' and 'saved_search' parameters cannot be combined"), nil
+	case cursorArg != "" && (query != "" || savedSearch != "" || priority != "" || !window.isZero() || sortArg != "" || orderArg != "" || hasOffset):
+		return mcp.NewToolResultError("the 'cursor' parameter cannot be combined with 'query', 'saved_search', 'priority', 'since', 'until', 'sort', 'order' or 'offset'"), nil
+	case fetchAll && (hasLimit || hasOffset || cursorArg != ""):
+		return mcp.NewToolResultError("the 'fetch_all' parameter cannot be combined with 'limit', 'offset' or 'cursor'"), nil
+	}
+
+	// Fail fast on malformed queries instead of waiting for OpsGenie to reject them
+	if err := checkAlertQuery(query); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	view, err := getAlertView(request, listedAlertFields)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	sortField, err := parseSortField(sortArg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	order, err := parseOrder(orderArg, alert.Desc)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	cursor := alertCursor{
+		Query:  query,
+		Sort:   sortField,
+		Order:  order,
+		Offset: request.GetInt("offset", 0),
+		Limit:  request.GetInt("limit", defaultAlertsPageSize),
+	}
+	if cursorArg != "" {
+		if cursor, err = decodeAlertCursor(cursorArg); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if hasLimit {
+			cursor.Limit = request.GetInt("limit", defaultAlertsPageSize)
+		}
+	}
+
+	switch {
+	case savedSearch != "":
+		// Resolve the saved search to its stored query
+		resolved, err := h.resolveSavedSearchQuery(ctx, savedSearch)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		cursor.Query = resolved
+	case cursor.Query == "" && cursorArg == "":
+		// Default to open alerts if no query is provided
+		cursor.Query = "status:open"
+	}
+
+	// Narrow down the query to the requested priorities
+	if cursor.Query, err = withPriorityFilter(cursor.Query, priority); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	cursor.Query = window.apply(cursor.Query)
+
+	var (
+		alerts     []alert.Alert
+		nextCursor string
+	)
+	if fetchAll {
+		// Fetch all alerts from OpsGenie matching the query
+		alerts, err = h.alertClient.ListAlerts(ctx, cursor.Query, cursor.Sort, cursor.Order)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alerts from OpsGenie: %v", err)), nil
+		}
+	} else {
+		if cursor.Limit < 1 || cursor.Limit > maxAlertsPageSize {
+			return mcp.NewToolResultError(fmt.Sprintf("the 'limit' parameter must be between 1 and %d", maxAlertsPageSize)), nil
+		}
+		if cursor.Offset < 0 || cursor.Offset+cursor.Limit > maxAlertsOffset {
+			return mcp.NewToolResultError(fmt.Sprintf("the 'offset' parameter must be between 0 and %d", maxAlertsOffset-cursor.Limit)), nil
+		}
+
+		// Fetch a single page of alerts from OpsGenie matching the query
+		alerts, err = h.alertClient.ListAlertsPage(ctx, cursor.Query, cursor.Sort, cursor.Order, cursor.Offset, cursor.Limit)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alerts from OpsGenie: %v", err)), nil
+		}
+
+		// A full page means that more alerts may match, unless the offset limit is reached
+		next := cursor
+		next.Offset += len(alerts)
+		if len(alerts) == cursor.Limit && next.Offset+next.Limit <= maxAlertsOffset {
+			if nextCursor, err = encodeAlertCursor(next); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+	}
+
+	if view.summary {
+		lines := make([]string, 0, len(alerts)+1)
+		for _, a := range alerts {
+			line, err := alertSummaryLine(a)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			lines = append(lines, line)
+		}
+		if nextCursor != "" {
+			lines = append(lines, fmt.Sprintf("next_cursor: %s", nextCursor))
+		}
+		return mcp.NewToolResultText(strings.Join(lines, "\n")), nil
+	}
+
+	result := listAlertsResult{
+		Alerts:     make([]any, 0, len(alerts)),
+		NextCursor: nextCursor,
+	}
+	for _, a := range alerts {
+		projected, err := view.project(a)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		result.Alerts = append(result.Alerts, projected)
+	}
+
+	// Serialize the alerts to JSON for the MCP response
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize alerts to JSON: %v", err)), nil
+	}
+
+	// Return the serialized alerts as a text result
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// namedAlertCount is the number of alerts matching a named query.
+type namedAlertCount struct {
+	Name  string `json:"name,omitempty"`
+	Query string `json:"query"`
+	Count int    `json:"count"`
+}
+
+// CountAlerts counts the OpsGenie alerts matching one or more search queries.
+// This method implements the MCP tool handler interface for the 'count_alerts' tool.
+func (h *opsgenieHandler) CountAlerts(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	query := request.GetString("query", "")
+	queries, err := getNamedQueries(request, "queries")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if query != "" && len(queries) > 0 {
+		return mcp.NewToolResultError("only one of the 'query' and 'queries' parameters can be provided"), nil
+	}
+	window, err := getTimeWindow(request, time.Now())
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Fail fast on malformed queries instead of waiting for OpsGenie to reject them
+	if err := checkAlertQuery(query); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	for _, q := range queries {
+		if err := checkAlertQuery(q.Query); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("query '%s': %v", q.Name, err)), nil
+		}
+	}
+
+	// Count a single query
+	if len(queries) == 0 {
+		if query == "" {
+			query = "status:open"
+		}
+		query = window.apply(query)
+
+		count, err := h.alertClient.CountAlerts(ctx, query)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to count alerts in OpsGenie: %v", err)), nil
+		}
+
+		data, err := json.Marshal(namedAlertCount{Query: query, Count: count})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize count to JSON: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(data)), nil
+	}
+
+	// Count each named query
+	for i := range queries {
+		queries[i].Query = window.apply(queries[i].Query)
+
+		count, err := h.alertClient.CountAlerts(ctx, queries[i].Query)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to count alerts for query '%s' in OpsGenie: %v", queries[i].Name, err)), nil
+		}
+		queries[i].Count = count
+	}
+
+	data, err := json.Marshal(queries)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize counts to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// GetAlert retrieves a single OpsGenie alert by its ID.
+// This method implements the MCP tool handler interface for the 'get_alert' tool.
+//
+// Parameters:
+//   - ctx: The context for the request, used for cancellation and timeouts
+//   - request: The MCP tool call request containing the alert ID parameter
+//
+// Returns:
+//   - A CallToolResult containing the serialized alert data on success
+//   - A CallToolResult with error information on failure
+//   - An error is only returned for internal MCP framework issues (always nil in this implementation)
+func (h *opsgenieHandler) GetAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract the alert ID parameter
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	view, err := getAlertView(request, alertFields)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Fetch the alert from OpsGenie
+	alert, err := h.alertClient.GetAlert(ctx, id, idType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alert with ID '%s' from OpsGenie: %v", id, err)), nil
+	}
+
+	if view.summary {
+		line, err := alertSummaryLine(alert)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return withWarning(mcp.NewToolResultText(line), warning), nil
+	}
+
+	projected, err := view.project(alert)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Serialize the alert to JSON for the MCP response
+	data, err := json.Marshal(projected)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize alert to JSON: %v", err)), nil
+	}
+
+	// Return the serialized alert as a text result
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// AcknowledgeAlert acknowledges an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'acknowledge_alert' tool.
+func (h *opsgenieHandler) AcknowledgeAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Acknowledge the alert
+	result, err := h.getAlertWriteClient(request).AcknowledgeAlert(ctx, id, idType, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to acknowledge alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// UnacknowledgeAlert unacknowledges an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'unacknowledge_alert' tool.
+func (h *opsgenieHandler) UnacknowledgeAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Unacknowledge the alert
+	result, err := h.getAlertWriteClient(request).UnacknowledgeAlert(ctx, id, idType, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to unacknowledge alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// CloseAlert closes an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'close_alert' tool.
+func (h *opsgenieHandler) CloseAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Close the alert
+	result, err := h.getAlertWriteClient(request).CloseAlert(ctx, id, idType, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to close alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// CreateAlert creates a new OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'create_alert' tool.
+func (h *opsgenieHandler) CreateAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	message := request.GetString("message", "")
+	if message == "" {
+		return mcp.NewToolResultError("the 'message' parameter is required"), nil
+	}
+
+	priority, err := parsePriority(request.GetString("priority", ""))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	responders, err := getResponders(request, "responders")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	visibleTo, err := getResponders(request, "visible_to")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	details, err := getStringMap(request, "details")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	createRequest := &alert.CreateAlertRequest{
+		Message:     message,
+		Alias:       request.GetString("alias", ""),
+		Description: request.GetString("description", ""),
+		Responders:  responders,
+		VisibleTo:   visibleTo,
+		Actions:     request.GetStringSlice("actions", nil),
+		Tags:        request.GetStringSlice("tags", nil),
+		Details:     details,
+		Entity:      request.GetString("entity", ""),
+		Priority:    priority,
+		Note:        request.GetString("note", ""),
+		User:        request.GetString("user", ""),
+		Source:      request.GetString("source", "mcp-opsgenie"),
+	}
+
+	// Create the alert
+	result, err := h.getAlertWriteClient(request).CreateAlert(ctx, createRequest)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create alert: %v", err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// snoozeAlertResult is the response of the 'snooze_alert' tool.
+type snoozeAlertResult struct {
+	*alert.RequestStatusResult
+	SnoozedUntil string `json:"snoozedUntil"`
+}
+
+// SnoozeAlert snoozes an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'snooze_alert' tool.
+func (h *opsgenieHandler) SnoozeAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	endTime, err := parseSnoozeEndTime(request.GetString("end_time", ""), request.GetString("duration", ""), time.Now(), h.maxSnoozeDuration)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Snooze the alert
+	result, err := h.getAlertWriteClient(request).SnoozeAlert(ctx, id, idType, endTime, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to snooze alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(snoozeAlertResult{
+		RequestStatusResult: result,
+		SnoozedUntil:        endTime.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// parseSnoozeEndTime computes the end time of a snooze from either an absolute RFC3339
+// timestamp or a duration relative to now. The end time must lie in the future and
+// no further than maxDuration from now.
+func parseSnoozeEndTime(endTimeArg, durationArg string, now time.Time, maxDuration time.Duration) (time.Time, error) {
+	var endTime time.Time
+
+	switch {
+	case endTimeArg != "" && durationArg != "":
+		return time.Time{}, fmt.Errorf("only one of the 'end_time' and 'duration' parameters can be provided")
+	case endTimeArg != "":
+		t, err := time.Parse(time.RFC3339, endTimeArg)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid 'end_time' '%s', must be in RFC3339 format: %w", endTimeArg, err)
+		}
+		endTime = t
+	case durationArg != "":
+		d, err := time.ParseDuration(durationArg)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid 'duration' '%s', must be a duration such as 45m or 2h30m: %w", durationArg, err)
+		}
+		endTime = now.Add(d)
+	default:
+		return time.Time{}, fmt.Errorf("either the 'end_time' or the 'duration' parameter is required")
+	}
+
+	if !endTime.After(now) {
+		return time.Time{}, fmt.Errorf("snooze end time %s is not in the future", endTime.UTC().Format(time.RFC3339))
+	}
+
+	if endTime.Sub(now) > maxDuration {
+		return time.Time{}, fmt.Errorf("snooze end time %s exceeds the maximum snooze duration of %s", endTime.UTC().Format(time.RFC3339), maxDuration)
+	}
+
+	return endTime, nil
+}
+
+// AddAlertNote adds a note to an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'add_alert_note' tool.
+func (h *opsgenieHandler) AddAlertNote(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	note := request.GetString("note", "")
+	if note == "" {
+		return mcp.NewToolResultError("the 'note' parameter is required"), nil
+	}
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Add the note to the alert
+	result, err := h.getAlertWriteClient(request).AddNote(ctx, id, idType, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to add note to alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// ListAlertNotes retrieves a page of notes of an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'list_alert_notes' tool.
+func (h *opsgenieHandler) ListAlertNotes(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	offset := request.GetString("offset", "")
+
+	direction := alert.RequestDirection(request.GetString("direction", string(alert.NEXT)))
+	if direction != alert.NEXT && direction != alert.PREV {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid direction '%s', must be one of next, prev", direction)), nil
+	}
+
+	order := alert.Order(request.GetString("order", string(alert.Desc)))
+	if order != alert.Asc && order != alert.Desc {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid order '%s', must be one of asc, desc", order)), nil
+	}
+
+	limit := request.GetInt("limit", 100)
+
+	// Fetch the notes from OpsGenie
+	notes, err := h.alertClient.ListAlertNotes(ctx, id, idType, offset, direction, order, limit)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve notes of alert with ID '%s' from OpsGenie: %v", id, err)), nil
+	}
+
+	// Serialize the notes to JSON
+	data, err := json.Marshal(notes)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize notes to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// AddAlertTags adds tags to an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'add_alert_tags' tool.
+func (h *opsgenieHandler) AddAlertTags(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	tags := request.GetStringSlice("tags", nil)
+	if len(tags) == 0 {
+		return mcp.NewToolResultError("the 'tags' parameter is required"), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Add the tags to the alert
+	result, err := h.getAlertWriteClient(request).AddTags(ctx, id, idType, tags, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to add tags to alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// RemoveAlertTags removes tags from an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'remove_alert_tags' tool.
+func (h *opsgenieHandler) RemoveAlertTags(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	tags := request.GetStringSlice("tags", nil)
+	if len(tags) == 0 {
+		return mcp.NewToolResultError("the 'tags' parameter is required"), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Remove the tags from the alert
+	result, err := h.getAlertWriteClient(request).RemoveTags(ctx, id, idType, tags, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to remove tags from alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// AddAlertDetails adds custom detail properties to an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'add_alert_details' tool.
+func (h *opsgenieHandler) AddAlertDetails(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	details, err := getStringMap(request, "details")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(details) == 0 {
+		return mcp.NewToolResultError("the 'details' parameter is required"), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Add the details to the alert
+	result, err := h.getAlertWriteClient(request).AddDetails(ctx, id, idType, details, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to add details to alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// RemoveAlertDetails removes custom detail properties from an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'remove_alert_details' tool.
+func (h *opsgenieHandler) RemoveAlertDetails(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	keys := request.GetStringSlice("keys", nil)
+	if len(keys) == 0 {
+		return mcp.NewToolResultError("the 'keys' parameter is required"), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Remove the details from the alert
+	result, err := h.getAlertWriteClient(request).RemoveDetails(ctx, id, idType, keys, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to remove details from alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// AssignAlert assigns an owner to an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'assign_alert' tool.
+func (h *opsgenieHandler) AssignAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	owner := request.GetString("owner", "")
+	if owner == "" {
+		return mcp.NewToolResultError("the 'owner' parameter is required"), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	var ownerUser alert.User
+	switch ownerType := request.GetString("owner_type", "username"); ownerType {
+	case "username":
+		ownerUser.Username = owner
+	case "id":
+		ownerUser.ID = owner
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid owner_type '%s', must be one of username, id", ownerType)), nil
+	}
+
+	// Assign the alert
+	result, err := h.getAlertWriteClient(request).AssignAlert(ctx, id, idType, ownerUser, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to assign alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// AddTeamToAlert adds a team to an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'add_team_to_alert' tool.
+func (h *opsgenieHandler) AddTeamToAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	teamIdentifier := request.GetString("team", "")
+	if teamIdentifier == "" {
+		return mcp.NewToolResultError("the 'team' parameter is required"), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	team, err := h.resolveTeam(ctx, teamIdentifier, request.GetString("team_type", "name"))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Add the team to the alert
+	result, err := h.getAlertWriteClient(request).AddTeam(ctx, id, idType, team, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to add team to alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// AddResponderToAlert adds a user or team responder to an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'add_responder_to_alert' tool.
+func (h *opsgenieHandler) AddResponderToAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	identifier := request.GetString("responder", "")
+	if identifier == "" {
+		return mcp.NewToolResultError("the 'responder' parameter is required"), nil
+	}
+	identifierType := request.GetString("responder_identifier_type", "name")
+	if identifierType != "name" && identifierType != "id" {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid responder_identifier_type '%s', must be one of name, id", identifierType)), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	responder := alert.Responder{
+		Type: alert.ResponderType(request.GetString("responder_type", "")),
+	}
+
+	switch responder.Type {
+	case alert.UserResponder:
+		if identifierType == "id" {
+			responder.Id = identifier
+		} else {
+			responder.Username = identifier
+		}
+	case alert.TeamResponder:
+		team, err := h.resolveTeam(ctx, identifier, identifierType)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		responder.Id = team.ID
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid responder_type '%s', must be one of user, team", responder.Type)), nil
+	}
+
+	// Add the responder to the alert
+	result, err := h.getAlertWriteClient(request).AddResponder(ctx, id, idType, responder, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to add responder to alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// resolveTeam looks up a team by name or ID through the team client, so that
+// unknown teams are reported before the alert is modified.
+func (h *opsgenieHandler) resolveTeam(ctx context.Context, identifier, identifierType string) (alert.Team, error) {
+	if identifierType != "name" && identifierType != "id" {
+		return alert.Team{}, fmt.Errorf("invalid team identifier type '%s', must be one of name, id", identifierType)
+	}
+
+	team, err := h.teamClient.GetTeam(ctx, identifier, identifierType)
+	if err != nil {
+		return alert.Team{}, fmt.Errorf("failed to resolve team '%s': %w", identifier, err)
+	}
+
+	return alert.Team{ID: team.Id, Name: team.Name}, nil
+}
+
+// EscalateAlert escalates an OpsGenie alert to the next level of an escalation policy.
+// This method implements the MCP tool handler interface for the 'escalate_alert' tool.
+func (h *opsgenieHandler) EscalateAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	escalationIdentifier := request.GetString("escalation", "")
+	if escalationIdentifier == "" {
+		return mcp.NewToolResultError("the 'escalation' parameter is required"), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	var escalation alert.Escalation
+	switch escalationType := request.GetString("escalation_type", "name"); escalationType {
+	case "name":
+		escalation.Name = escalationIdentifier
+	case "id":
+		escalation.ID = escalationIdentifier
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid escalation_type '%s', must be one of name, id", escalationType)), nil
+	}
+
+	// Escalate the alert
+	result, err := h.getAlertWriteClient(request).EscalateAlert(ctx, id, idType, escalation, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to escalate alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// ExecuteAlertAction executes a custom action on an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'execute_alert_action' tool.
+func (h *opsgenieHandler) ExecuteAlertAction(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	action := request.GetString("action", "")
+	if action == "" {
+		return mcp.NewToolResultError("the 'action' parameter is required"), nil
+	}
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Make sure the action is available on the alert before executing it
+	existing, err := h.alertClient.GetAlert(ctx, id, idType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alert with ID '%s' from OpsGenie: %v", id, err)), nil
+	}
+	if !slices.Contains(existing.Actions, action) {
+		if len(existing.Actions) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("action '%s' is not available, alert with ID '%s' has no custom actions", action, id)), nil
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("action '%s' is not available on alert with ID '%s', available actions: %s", action, id, strings.Join(existing.Actions, ", "))), nil
+	}
+
+	// Execute the action
+	result, err := h.getAlertWriteClient(request).ExecuteCustomAction(ctx, id, idType, action, user, note, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to execute action '%s' on alert with ID '%s': %v", action, id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// updateAlertResult is the response of the alert update tools. It contains the
+// value of the updated field as fetched before and after the update.
+type updateAlertResult struct {
+	*alert.RequestStatusResult
+	Field  string  `json:"field"`
+	Before string  `json:"before"`
+	After  *string `json:"after,omitempty"`
+}
+
+// UpdateAlertPriority updates the priority of an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'update_alert_priority' tool.
+func (h *opsgenieHandler) UpdateAlertPriority(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	priorityArg := request.GetString("priority", "")
+	if priorityArg == "" {
+		return mcp.NewToolResultError("the 'priority' parameter is required"), nil
+	}
+	priority, err := parsePriority(priorityArg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return h.updateAlertField(ctx, id, idType, request.GetBool("wait", true), warning, "priority",
+		func(a *alert.GetAlertResult) string { return string(a.Priority) },
+		func() (*alert.RequestStatusResult, error) {
+			return h.getAlertWriteClient(request).UpdatePriority(ctx, id, idType, priority)
+		},
+	)
+}
+
+// UpdateAlertMessage updates the message of an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'update_alert_message' tool.
+func (h *opsgenieHandler) UpdateAlertMessage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	message := request.GetString("message", "")
+	if message == "" {
+		return mcp.NewToolResultError("the 'message' parameter is required"), nil
+	}
+
+	return h.updateAlertField(ctx, id, idType, request.GetBool("wait", true), warning, "message",
+		func(a *alert.GetAlertResult) string { return a.Message },
+		func() (*alert.RequestStatusResult, error) {
+			return h.getAlertWriteClient(request).UpdateMessage(ctx, id, idType, message)
+		},
+	)
+}
+
+// UpdateAlertDescription updates the description of an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'update_alert_description' tool.
+func (h *opsgenieHandler) UpdateAlertDescription(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	description := request.GetString("description", "")
+	if description == "" {
+		return mcp.NewToolResultError("the 'description' parameter is required"), nil
+	}
+
+	return h.updateAlertField(ctx, id, idType, request.GetBool("wait", true), warning, "description",
+		func(a *alert.GetAlertResult) string { return a.Description },
+		func() (*alert.RequestStatusResult, error) {
+			return h.getAlertWriteClient(request).UpdateDescription(ctx, id, idType, description)
+		},
+	)
+}
+
+// updateAlertField runs an alert update and reports the value of the updated
+// field as fetched from OpsGenie before and after the update. The value after
+// the update is only reported when waiting for the update to be processed.
+func (h *opsgenieHandler) updateAlertField(ctx context.Context, id string, idType alert.AlertIdentifier, wait bool, warning, field string, value func(*alert.GetAlertResult) string, update func() (*alert.RequestStatusResult, error)) (*mcp.CallToolResult, error) {
+	before, err := h.alertClient.GetAlert(ctx, id, idType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alert with ID '%s' from OpsGenie: %v", id, err)), nil
+	}
+
+	result, err := update()
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update %s of alert with ID '%s': %v", field, id, err)), nil
+	}
+
+	updateResult := updateAlertResult{
+		RequestStatusResult: result,
+		Field:               field,
+		Before:              value(before),
+	}
+
+	if wait {
+		after, err := h.alertClient.GetAlert(ctx, id, idType)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Updated %s of alert with ID '%s', but failed to retrieve the updated alert: %v", field, id, err)), nil
+		}
+		afterValue := value(after)
+		updateResult.After = &afterValue
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(updateResult)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// DeleteAlert permanently deletes an OpsGenie alert after checking that the
+// confirmation tiny ID matches the alert.
+// This method implements the MCP tool handler interface for the 'delete_alert' tool.
+func (h *opsgenieHandler) DeleteAlert(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	confirmTinyID := request.GetString("confirm_tiny_id", "")
+	if confirmTinyID == "" {
+		return mcp.NewToolResultError("the 'confirm_tiny_id' parameter is required"), nil
+	}
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Make sure the confirmation matches the alert that is about to be deleted
+	existing, err := h.alertClient.GetAlert(ctx, id, idType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alert with ID '%s' from OpsGenie: %v", id, err)), nil
+	}
+	if existing.TinyId != confirmTinyID {
+		return mcp.NewToolResultError(fmt.Sprintf("refusing to delete alert with ID '%s': confirmation tiny ID '%s' does not match the alert", id, confirmTinyID)), nil
+	}
+
+	// Delete the alert
+	result, err := h.getAlertWriteClient(request).DeleteAlert(ctx, id, idType, source)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to delete alert with ID '%s': %v", id, err)), nil
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// alertLogEntry is a single alert log entry as returned by the 'list_alert_logs' tool.
+// The creation time is exposed both as epoch milliseconds and in RFC3339 format.
+type alertLogEntry struct {
+	Log             string `json:"log"`
+	Type            string `json:"type,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	CreatedAt       string `json:"createdAt"`
+	CreatedAtMillis int64  `json:"createdAtMillis"`
+	Offset          string `json:"offset,omitempty"`
+}
+
+// ListAlertLogs retrieves the activity log of an OpsGenie alert.
+// This method implements the MCP tool handler interface for the 'list_alert_logs' tool.
+func (h *opsgenieHandler) ListAlertLogs(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	order := alert.Order(request.GetString("order", string(alert.Asc)))
+	if order != alert.Asc && order != alert.Desc {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid order '%s', must be one of asc, desc", order)), nil
+	}
+
+	// Fetch the logs from OpsGenie
+	logs, truncated, err := h.alertClient.ListAlertLogs(ctx, id, idType, order)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve logs of alert with ID '%s' from OpsGenie: %v", id, err)), nil
+	}
+
+	entries := make([]alertLogEntry, 0, len(logs))
+	for _, l := range logs {
+		entries = append(entries, alertLogEntry{
+			Log:             l.Log,
+			Type:            l.Type,
+			Owner:           l.Owner,
+			CreatedAt:       l.CreatedAt.UTC().Format(time.RFC3339Nano),
+			CreatedAtMillis: l.CreatedAt.UnixMilli(),
+			Offset:          l.Offset,
+		})
+	}
+
+	// Serialize the logs to JSON
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize logs to JSON: %v", err)), nil
+	}
+
+	result := mcp.NewToolResultText(string(data))
+	if truncated {
+		result = withWarning(result, fmt.Sprintf("Warning: the log of the alert is truncated to its first %d entries in '%s' order.", len(logs), order))
+	}
+
+	return withWarning(result, warning), nil
+}
+
+// alertRecipientsResult is the response of the 'list_alert_recipients' tool.
+type alertRecipientsResult struct {
+	// StateCounts maps each notification state to the number of recipients in that state.
+	StateCounts map[string]int         `json:"stateCounts"`
+	Recipients  []alert.AlertRecipient `json:"recipients"`
+}
+
+// ListAlertRecipients retrieves the recipients of an OpsGenie alert and their notification state.
+// This method implements the MCP tool handler interface for the 'list_alert_recipients' tool.
+func (h *opsgenieHandler) ListAlertRecipients(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Fetch the recipients from OpsGenie
+	recipients, err := h.alertClient.ListAlertRecipients(ctx, id, idType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve recipients of alert with ID '%s' from OpsGenie: %v", id, err)), nil
+	}
+
+	result := alertRecipientsResult{
+		StateCounts: make(map[string]int),
+		Recipients:  recipients,
+	}
+	for _, r := range recipients {
+		result.StateCounts[r.State]++
+	}
+
+	// Serialize the recipients to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize recipients to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
 }
@@ -0,0 +1,228 @@
+package mcp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/giantswarm/mcp-opsgenie/pkg/opsgenie"
+)
+
+// maxInlineAttachmentSize is the maximum size of an attachment that is returned inline.
+// Larger attachments are returned as a resource link to keep the response small.
+const maxInlineAttachmentSize = 1 << 20
+
+// inlineTextMimeTypes lists non "text/*" MIME types whose content is returned inline as text.
+var inlineTextMimeTypes = []string{
+	"application/json",
+	"application/x-ndjson",
+	"application/xml",
+	"application/yaml",
+	"application/x-yaml",
+}
+
+func (h *opsgenieHandler) registerAlertAttachmentTools(s *server.MCPServer) {
+	listAlertAttachmentsTool := mcp.NewTool("list_alert_attachments",
+		mcp.WithDescription("Retrieves the IDs and names of the attachments of an alert from OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to list the attachments of."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(listAlertAttachmentsTool, h.ListAlertAttachments)
+
+	getAlertAttachmentTool := mcp.NewTool("get_alert_attachment",
+		mcp.WithDescription("Retrieves an attachment of an alert from OpsGenie. Text attachments are returned inline, images as image content and anything else as a link to download the attachment."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert the attachment belongs to."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("attachment_id",
+			mcp.Description("ID of the attachment, as returned by 'list_alert_attachments'."),
+			mcp.Required(),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(getAlertAttachmentTool, h.GetAlertAttachment)
+
+	uploadAlertAttachmentTool := mcp.NewTool("upload_alert_attachment",
+		mcp.WithDescription("Attaches a text file to an alert in OpsGenie."),
+		mcp.WithString("id",
+			mcp.Description("Identifier of the alert to attach the file to."),
+			mcp.Required(),
+		),
+		withAlertIdentifierType(),
+		mcp.WithString("file_name",
+			mcp.Description("Name of the attachment, including its extension (e.g. triage-notes.md). It must not contain path separators."),
+			mcp.Required(),
+		),
+		mcp.WithString("content",
+			mcp.Description("Text content of the attachment."),
+			mcp.Required(),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(uploadAlertAttachmentTool, h.UploadAlertAttachment)
+}
+
+// ListAlertAttachments retrieves the attachments of an OpsGenie alert.
+func (h *opsgenieHandler) ListAlertAttachments(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	attachments, err := h.alertClient.ListAttachments(ctx, id, idType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve attachments of alert with ID '%s' from OpsGenie: %v", id, err)), nil
+	}
+
+	data, err := json.Marshal(attachments)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize attachments to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// GetAlertAttachment retrieves an attachment of an OpsGenie alert. Text attachments are
+// returned inline, images as image content and any other attachment as a resource link.
+func (h *opsgenieHandler) GetAlertAttachment(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	attachmentID := request.GetString("attachment_id", "")
+	if attachmentID == "" {
+		return mcp.NewToolResultError("the 'attachment_id' parameter is required"), nil
+	}
+
+	attachment, err := h.alertClient.GetAttachment(ctx, id, idType, attachmentID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve attachment '%s' of alert with ID '%s' from OpsGenie: %v", attachmentID, id, err)), nil
+	}
+
+	// Skip the download for attachments that cannot be returned inline anyway
+	mimeType := baseMimeType(mime.TypeByExtension(path.Ext(attachment.Name)))
+	if mimeType != "" && !isInlineMimeType(mimeType) {
+		return withWarning(attachmentLinkResult(attachment.Name, attachment.Url, mimeType, ""), warning), nil
+	}
+
+	content, contentType, err := h.alertClient.DownloadAttachment(ctx, attachment.Url, maxInlineAttachmentSize)
+	if errors.Is(err, opsgenie.ErrAttachmentTooLarge) {
+		return withWarning(attachmentLinkResult(attachment.Name, attachment.Url, mimeType, fmt.Sprintf("Attachment is larger than %d bytes and is not returned inline.", maxInlineAttachmentSize)), warning), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to download attachment '%s' of alert with ID '%s': %v", attachmentID, id, err)), nil
+	}
+
+	if mimeType == "" {
+		mimeType = baseMimeType(contentType)
+	}
+	if mimeType == "" || mimeType == "application/octet-stream" {
+		mimeType = baseMimeType(http.DetectContentType(content))
+	}
+
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return withWarning(mcp.NewToolResultImage(attachment.Name, base64.StdEncoding.EncodeToString(content), mimeType), warning), nil
+	case isInlineMimeType(mimeType):
+		return withWarning(mcp.NewToolResultText(string(content)), warning), nil
+	default:
+		return withWarning(attachmentLinkResult(attachment.Name, attachment.Url, mimeType, ""), warning), nil
+	}
+}
+
+// UploadAlertAttachment attaches a text file to an OpsGenie alert.
+func (h *opsgenieHandler) UploadAlertAttachment(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, idType, warning, err := h.getAlertIdentifier(ctx, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	fileName := request.GetString("file_name", "")
+	if fileName == "" {
+		return mcp.NewToolResultError("the 'file_name' parameter is required"), nil
+	}
+	if fileName != filepath.Base(fileName) || strings.ContainsRune(fileName, '\\') {
+		return mcp.NewToolResultError("the 'file_name' parameter must not contain path separators"), nil
+	}
+	if fileName == "." || fileName == ".." {
+		return mcp.NewToolResultError(fmt.Sprintf("the 'file_name' parameter must be a file name, not '%s'", fileName)), nil
+	}
+	content := request.GetString("content", "")
+	if content == "" {
+		return mcp.NewToolResultError("the 'content' parameter is required"), nil
+	}
+	user := request.GetString("user", "")
+
+	result, err := h.alertClient.UploadAttachment(ctx, id, idType, fileName, []byte(content), user)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to upload attachment to alert with ID '%s': %v", id, err)), nil
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// attachmentLinkResult returns an attachment as a resource link pointing to its download URL.
+func attachmentLinkResult(name, url, mimeType, description string) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewResourceLink(url, name, description, mimeType),
+		},
+	}
+}
+
+// baseMimeType strips any parameters, such as the charset, from a MIME type.
+func baseMimeType(mimeType string) string {
+	if mimeType == "" {
+		return ""
+	}
+
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return ""
+	}
+
+	return mediaType
+}
+
+// isInlineMimeType reports whether an attachment of the given MIME type is returned
+// inline, either as text or as image content.
+func isInlineMimeType(mimeType string) bool {
+	if strings.HasPrefix(mimeType, "text/") || strings.HasPrefix(mimeType, "image/") {
+		return true
+	}
+
+	return slices.Contains(inlineTextMimeTypes, mimeType)
+}
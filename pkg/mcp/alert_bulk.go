@@ -0,0 +1,220 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+// bulkConcurrency is the maximum number of alerts that are updated concurrently by the bulk tools.
+const bulkConcurrency = 5
+
+// bulkAlertAction updates a single alert as part of a bulk operation.
+type bulkAlertAction func(ctx context.Context, id string, idType alert.AlertIdentifier, user, note, source string) (*alert.RequestStatusResult, error)
+
+// bulkAlertPreview is an alert that would be affected by a bulk operation.
+type bulkAlertPreview struct {
+	Id      string `json:"id"`
+	TinyId  string `json:"tinyId,omitempty"`
+	Message string `json:"message,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+// bulkAlertOutcome is the outcome of a bulk operation for a single alert.
+type bulkAlertOutcome struct {
+	bulkAlertPreview
+	Success   bool   `json:"success"`
+	RequestId string `json:"requestId,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// bulkAlertResult is the response of the bulk alert tools.
+type bulkAlertResult struct {
+	Query     string             `json:"query"`
+	Preview   bool               `json:"preview"`
+	Matched   int                `json:"matched"`
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Alerts    []bulkAlertPreview `json:"alerts,omitempty"`
+	Results   []bulkAlertOutcome `json:"results,omitempty"`
+}
+
+func (h *opsgenieHandler) registerAlertBulkTools(s *server.MCPServer) {
+	bulkAcknowledgeAlertsTool := mcp.NewTool("bulk_acknowledge_alerts",
+		withBulkAlertOptions("acknowledge",
+			"Acknowledges all alerts matching a search query, at most 'max_count' of them. Runs in preview mode by default, which only lists the alerts that would be acknowledged. Reports success or failure per alert.",
+		)...,
+	)
+	s.AddTool(bulkAcknowledgeAlertsTool, h.BulkAcknowledgeAlerts)
+
+	bulkCloseAlertsTool := mcp.NewTool("bulk_close_alerts",
+		withBulkAlertOptions("close",
+			"Closes all alerts matching a search query, at most 'max_count' of them. Runs in preview mode by default, which only lists the alerts that would be closed. Reports success or failure per alert.",
+		)...,
+	)
+	s.AddTool(bulkCloseAlertsTool, h.BulkCloseAlerts)
+}
+
+// withBulkAlertOptions returns the description, arguments and annotations of a bulk alert tool.
+func withBulkAlertOptions(verb, description string) []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithDescription(description),
+		mcp.WithString("query",
+			mcp.Description(fmt.Sprintf("Search query selecting the alerts to %s, using the same syntax as 'list_alerts' (e.g. \"status:open AND tag:outage-42\").", verb)),
+			mcp.Required(),
+		),
+		mcp.WithNumber("max_count",
+			mcp.Description(fmt.Sprintf("Maximum number of alerts to %s, at most %d. The operation is refused if the query matches more alerts.", verb, maxAlertsOffset)),
+			mcp.Min(1),
+			mcp.Max(maxAlertsOffset),
+			mcp.Required(),
+		),
+		mcp.WithBoolean("preview",
+			mcp.Description(fmt.Sprintf("If true, only list the alerts that would be affected without changing them. Set to false to %s the alerts. Defaults to true.", verb)),
+			mcp.DefaultBool(true),
+		),
+		mcp.WithString("note",
+			mcp.Description("Optional note to add to each alert."),
+		),
+		mcp.WithString("user",
+			mcp.Description("Optional display name of the request owner."),
+		),
+		mcp.WithString("source",
+			mcp.Description("Optional display name of the request source."),
+		),
+		withWait(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	}
+}
+
+// BulkAcknowledgeAlerts acknowledges all OpsGenie alerts matching a query.
+func (h *opsgenieHandler) BulkAcknowledgeAlerts(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return h.bulkUpdateAlerts(ctx, request, "acknowledge", h.getAlertWriteClient(request).AcknowledgeAlert)
+}
+
+// BulkCloseAlerts closes all OpsGenie alerts matching a query.
+func (h *opsgenieHandler) BulkCloseAlerts(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return h.bulkUpdateAlerts(ctx, request, "close", h.getAlertWriteClient(request).CloseAlert)
+}
+
+// bulkUpdateAlerts applies an action to every alert matching the query of the request,
+// refusing to do so if more alerts than the requested maximum match.
+func (h *opsgenieHandler) bulkUpdateAlerts(ctx context.Context, request mcp.CallToolRequest, verb string, action bulkAlertAction) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	query := request.GetString("query", "")
+	if query == "" {
+		return mcp.NewToolResultError("the 'query' parameter is required"), nil
+	}
+	maxCount := request.GetInt("max_count", 0)
+	if maxCount <= 0 {
+		return mcp.NewToolResultError("the 'max_count' parameter is required and must be positive"), nil
+	}
+	if maxCount > maxAlertsOffset {
+		return mcp.NewToolResultError(fmt.Sprintf("the 'max_count' parameter must be at most %d, the number of alerts OpsGenie can list for a query", maxAlertsOffset)), nil
+	}
+	preview := request.GetBool("preview", true)
+	note := request.GetString("note", "")
+	user := request.GetString("user", "")
+	source := request.GetString("source", "mcp-opsgenie")
+
+	// Fail fast on malformed queries instead of waiting for OpsGenie to reject them
+	if err := checkAlertQuery(query); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Check the number of matching alerts before fetching any of them
+	count, err := h.alertClient.CountAlerts(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to count alerts in OpsGenie: %v", err)), nil
+	}
+	if count > maxCount {
+		return mcp.NewToolResultError(fmt.Sprintf("refusing to %s %d alerts matching query '%s': exceeds max_count of %d", verb, count, query, maxCount)), nil
+	}
+
+	// Find the alerts matching the query. Alerts created since counting are not acted upon beyond max_count.
+	alerts, err := h.alertClient.ListAlertsUpTo(ctx, query, alert.CreatedAt, alert.Desc, maxCount)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alerts from OpsGenie: %v", err)), nil
+	}
+
+	result := bulkAlertResult{
+		Query:   query,
+		Preview: preview,
+		Matched: len(alerts),
+	}
+
+	if preview {
+		result.Alerts = make([]bulkAlertPreview, 0, len(alerts))
+		for _, a := range alerts {
+			result.Alerts = append(result.Alerts, newBulkAlertPreview(a))
+		}
+	} else {
+		result.Results = runBulkAlertAction(ctx, alerts, action, user, note, source)
+		for _, r := range result.Results {
+			if r.Success {
+				result.Succeeded++
+			} else {
+				result.Failed++
+			}
+		}
+	}
+
+	// Serialize the result to JSON
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// runBulkAlertAction applies an action to the given alerts with bounded concurrency.
+// The outcomes are returned in the same order as the alerts.
+func runBulkAlertAction(ctx context.Context, alerts []alert.Alert, action bulkAlertAction, user, note, source string) []bulkAlertOutcome {
+	outcomes := make([]bulkAlertOutcome, len(alerts))
+	semaphore := make(chan struct{}, bulkConcurrency)
+
+	var wg sync.WaitGroup
+	for i, a := range alerts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			outcome := bulkAlertOutcome{bulkAlertPreview: newBulkAlertPreview(a)}
+
+			status, err := action(ctx, a.Id, alert.ALERTID, user, note, source)
+			if err != nil {
+				outcome.Error = err.Error()
+			} else {
+				outcome.Success = true
+				outcome.RequestId = status.RequestId
+			}
+
+			outcomes[i] = outcome
+		}()
+	}
+	wg.Wait()
+
+	return outcomes
+}
+
+// newBulkAlertPreview returns the identifying fields of an alert.
+func newBulkAlertPreview(a alert.Alert) bulkAlertPreview {
+	return bulkAlertPreview{
+		Id:      a.Id,
+		TinyId:  a.TinyID,
+		Message: a.Message,
+		Status:  a.Status,
+	}
+}
@@ -0,0 +1,47 @@
+package mcp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+// alertCursor is the state needed to fetch the next page of a 'list_alerts' search.
+// It is handed to clients as an opaque string in the 'next_cursor' field.
+type alertCursor struct {
+	Query  string          `json:"q"`
+	Sort   alert.SortField `json:"s"`
+	Order  alert.Order     `json:"d"`
+	Offset int             `json:"o"`
+	Limit  int             `json:"l"`
+}
+
+// encodeAlertCursor serializes a cursor into an opaque string.
+func encodeAlertCursor(cursor alertCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeAlertCursor parses a cursor previously returned by encodeAlertCursor.
+func decodeAlertCursor(value string) (alertCursor, error) {
+	var cursor alertCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return alertCursor{}, fmt.Errorf("invalid cursor '%s'", value)
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return alertCursor{}, fmt.Errorf("invalid cursor '%s'", value)
+	}
+	if cursor.Offset < 0 || cursor.Limit < 1 || cursor.Sort == "" || cursor.Order == "" {
+		return alertCursor{}, fmt.Errorf("invalid cursor '%s'", value)
+	}
+
+	return cursor, nil
+}
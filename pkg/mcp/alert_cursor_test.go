@@ -0,0 +1,123 @@
+package mcp
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+func TestAlertCursorRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cursor alertCursor
+	}{
+		{
+			name: "first page",
+			cursor: alertCursor{
+				Query:  "status:open",
+				Sort:   alert.CreatedAt,
+				Order:  alert.Desc,
+				Offset: 0,
+				Limit:  20,
+			},
+		},
+		{
+			name: "later page with special characters in query",
+			cursor: alertCursor{
+				Query:  `(status:open AND teams:"SRE team") AND createdAt >= 1714550400000`,
+				Sort:   alert.Status,
+				Order:  alert.Asc,
+				Offset: 19900,
+				Limit:  100,
+			},
+		},
+		{
+			name: "empty query",
+			cursor: alertCursor{
+				Sort:   alert.UpdatedAt,
+				Order:  alert.Desc,
+				Offset: 40,
+				Limit:  1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded, err := encodeAlertCursor(tc.cursor)
+			if err != nil {
+				t.Fatalf("unexpected error encoding cursor: %v", err)
+			}
+
+			decoded, err := decodeAlertCursor(encoded)
+			if err != nil {
+				t.Fatalf("unexpected error decoding cursor: %v", err)
+			}
+			if decoded != tc.cursor {
+				t.Errorf("expected cursor %+v, got %+v", tc.cursor, decoded)
+			}
+		})
+	}
+}
+
+func TestDecodeAlertCursorRejectsInvalidCursors(t *testing.T) {
+	encode := func(json string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(json))
+	}
+
+	testCases := []struct {
+		name  string
+		value string
+	}{
+		{
+			name:  "not base64",
+			value: "not a cursor!",
+		},
+		{
+			name:  "padded base64",
+			value: base64.URLEncoding.EncodeToString([]byte(`{"q":"status:open","s":"createdAt","d":"desc","o":0,"l":20}`)),
+		},
+		{
+			name:  "not JSON",
+			value: encode("status:open"),
+		},
+		{
+			name:  "wrong JSON types",
+			value: encode(`{"q":"status:open","s":"createdAt","d":"desc","o":"20","l":20}`),
+		},
+		{
+			name:  "legacy cursor without sort and order",
+			value: encode(`{"q":"status:open","o":20,"l":20}`),
+		},
+		{
+			name:  "missing sort",
+			value: encode(`{"q":"status:open","d":"desc","o":20,"l":20}`),
+		},
+		{
+			name:  "missing order",
+			value: encode(`{"q":"status:open","s":"createdAt","o":20,"l":20}`),
+		},
+		{
+			name:  "negative offset",
+			value: encode(`{"q":"status:open","s":"createdAt","d":"desc","o":-20,"l":20}`),
+		},
+		{
+			name:  "zero limit",
+			value: encode(`{"q":"status:open","s":"createdAt","d":"desc","o":20,"l":0}`),
+		},
+		{
+			name:  "missing limit",
+			value: encode(`{"q":"status:open","s":"createdAt","d":"desc","o":20}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cursor, err := decodeAlertCursor(tc.value)
+			if err == nil {
+				t.Fatalf("expected an error, got cursor %+v", cursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+// alertIdentifierTypeDescription documents the 'identifier_type' argument shared by all alert tools.
+const alertIdentifierTypeDescription = `Type of the alert identifier given in 'id'. Possible values are:
+- 'auto' (default): UUIDs are treated as alert IDs, numbers as tiny IDs and anything else as an alias
+- 'id': the alert ID
+- 'alias': the client-defined alias of the alert
+- 'tiny': the short tiny ID of the alert (not recommended, tiny IDs roll over)`
+
+var (
+	// alertIDPattern matches OpsGenie alert IDs, which are UUIDs optionally followed by a numeric suffix.
+	alertIDPattern = regexp.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}(-\d+)?$`)
+
+	// tinyIDPattern matches OpsGenie tiny IDs.
+	tinyIDPattern = regexp.MustCompile(`^\d+$`)
+)
+
+// withAlertIdentifierType adds the 'identifier_type' argument to an alert tool.
+func withAlertIdentifierType() mcp.ToolOption {
+	return mcp.WithString("identifier_type",
+		mcp.Description(alertIdentifierTypeDescription),
+		mcp.Enum("auto", "id", "alias", "tiny"),
+	)
+}
+
+// getAlertIdentifier extracts the 'id' and 'identifier_type' arguments of an alert tool.
+// For tiny IDs, which roll over, it returns a warning if the tiny ID matches more than one alert.
+func (h *opsgenieHandler) getAlertIdentifier(ctx context.Context, request mcp.CallToolRequest) (string, alert.AlertIdentifier, string, error) {
+	id := request.GetString("id", "")
+	if id == "" {
+		return "", 0, "", fmt.Errorf("the 'id' parameter is required")
+	}
+
+	idType, err := parseAlertIdentifierType(id, request.GetString("identifier_type", "auto"))
+	if err != nil {
+		return "", 0, "", err
+	}
+
+	if idType != alert.TINYID {
+		return id, idType, "", nil
+	}
+
+	alerts, err := h.alertClient.ListAlerts(ctx, fmt.Sprintf("tinyId:%s", id), alert.CreatedAt, alert.Desc)
+	if err != nil {
+		return "", 0, "", fmt.Errorf("failed to check tiny ID '%s' for duplicates: %w", id, err)
+	}
+
+	var warning string
+	if len(alerts) > 1 {
+		ids := make([]string, 0, len(alerts))
+		for _, a := range alerts {
+			ids = append(ids, a.Id)
+		}
+		warning = fmt.Sprintf("Warning: tiny ID '%s' matches %d alerts (%s) because tiny IDs roll over. The request may not have targeted the intended alert; pass the alert ID with identifier_type 'id' to target a specific alert.", id, len(alerts), strings.Join(ids, ", "))
+	}
+
+	return id, idType, warning, nil
+}
+
+// parseAlertIdentifierType maps an 'identifier_type' argument to an OpsGenie alert identifier type,
+// detecting the type from the identifier itself in 'auto' mode.
+func parseAlertIdentifierType(id, identifierType string) (alert.AlertIdentifier, error) {
+	switch identifierType {
+	case "id":
+		return alert.ALERTID, nil
+	case "alias":
+		return alert.ALIAS, nil
+	case "tiny":
+		if !tinyIDPattern.MatchString(id) {
+			return 0, fmt.Errorf("invalid tiny ID '%s', must be numeric", id)
+		}
+		return alert.TINYID, nil
+	case "auto", "":
+		switch {
+		case alertIDPattern.MatchString(id):
+			return alert.ALERTID, nil
+		case tinyIDPattern.MatchString(id):
+			return alert.TINYID, nil
+		default:
+			return alert.ALIAS, nil
+		}
+	default:
+		return 0, fmt.Errorf("invalid identifier_type '%s', must be one of auto, id, alias, tiny", identifierType)
+	}
+}
+
+// withWarning prepends a warning to a tool result. An empty warning leaves the result unchanged.
+func withWarning(result *mcp.CallToolResult, warning string) *mcp.CallToolResult {
+	if warning == "" {
+		return result
+	}
+
+	result.Content = append([]mcp.Content{mcp.NewTextContent(warning)}, result.Content...)
+
+	return result
+}
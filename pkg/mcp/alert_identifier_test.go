@@ -0,0 +1,196 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+func TestParseAlertIdentifierType(t *testing.T) {
+	testCases := []struct {
+		name           string
+		id             string
+		identifierType string
+		expected       alert.AlertIdentifier
+		expectError    bool
+	}{
+		{
+			name:           "auto detects UUID as alert ID",
+			id:             "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: "auto",
+			expected:       alert.ALERTID,
+		},
+		{
+			name:           "auto detects UUID with numeric suffix as alert ID",
+			id:             "70413a06-38d6-4c85-92b8-5ebc900d42e2-1577836800000",
+			identifierType: "auto",
+			expected:       alert.ALERTID,
+		},
+		{
+			name:           "auto detects upper case UUID as alert ID",
+			id:             "70413A06-38D6-4C85-92B8-5EBC900D42E2",
+			identifierType: "auto",
+			expected:       alert.ALERTID,
+		},
+		{
+			name:           "auto detects number as tiny ID",
+			id:             "1791",
+			identifierType: "auto",
+			expected:       alert.TINYID,
+		},
+		{
+			name:           "empty identifier type defaults to auto",
+			id:             "1791",
+			identifierType: "",
+			expected:       alert.TINYID,
+		},
+		{
+			name:           "auto detects anything else as alias",
+			id:             "cluster-a/disk-full",
+			identifierType: "auto",
+			expected:       alert.ALIAS,
+		},
+		{
+			name:           "auto treats UUID with non-numeric suffix as alias",
+			id:             "70413a06-38d6-4c85-92b8-5ebc900d42e2-x",
+			identifierType: "auto",
+			expected:       alert.ALIAS,
+		},
+		{
+			name:           "auto treats truncated UUID as alias",
+			id:             "70413a06-38d6-4c85-92b8-5ebc900d42e",
+			identifierType: "auto",
+			expected:       alert.ALIAS,
+		},
+		{
+			name:           "auto treats number with whitespace as alias",
+			id:             " 1791",
+			identifierType: "auto",
+			expected:       alert.ALIAS,
+		},
+		{
+			name:           "explicit alias keeps numeric alias",
+			id:             "1791",
+			identifierType: "alias",
+			expected:       alert.ALIAS,
+		},
+		{
+			name:           "explicit alias keeps UUID alias",
+			id:             "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: "alias",
+			expected:       alert.ALIAS,
+		},
+		{
+			name:           "explicit id accepts any value",
+			id:             "not-a-uuid",
+			identifierType: "id",
+			expected:       alert.ALERTID,
+		},
+		{
+			name:           "explicit tiny accepts number",
+			id:             "42",
+			identifierType: "tiny",
+			expected:       alert.TINYID,
+		},
+		{
+			name:           "explicit tiny rejects non-numeric value",
+			id:             "42a",
+			identifierType: "tiny",
+			expectError:    true,
+		},
+		{
+			name:           "explicit tiny rejects UUID",
+			id:             "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: "tiny",
+			expectError:    true,
+		},
+		{
+			name:           "explicit tiny rejects empty value",
+			id:             "",
+			identifierType: "tiny",
+			expectError:    true,
+		},
+		{
+			name:           "unknown identifier type",
+			id:             "1791",
+			identifierType: "tinyId",
+			expectError:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idType, err := parseAlertIdentifierType(tc.id, tc.identifierType)
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got identifier type %d", idType)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if idType != tc.expected {
+				t.Errorf("expected identifier type %d, got %d", tc.expected, idType)
+			}
+		})
+	}
+}
+
+func TestWithWarning(t *testing.T) {
+	testCases := []struct {
+		name     string
+		warning  string
+		expected []string
+	}{
+		{
+			name:     "empty warning leaves result unchanged",
+			warning:  "",
+			expected: []string{"result"},
+		},
+		{
+			name:     "warning is prepended",
+			warning:  "Warning: something",
+			expected: []string{"Warning: something", "result"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := withWarning(mcp.NewToolResultText("result"), tc.warning)
+
+			if len(result.Content) != len(tc.expected) {
+				t.Fatalf("expected %d contents, got %d", len(tc.expected), len(result.Content))
+			}
+			for i, expected := range tc.expected {
+				text, ok := result.Content[i].(mcp.TextContent)
+				if !ok {
+					t.Fatalf("expected text content at index %d, got %T", i, result.Content[i])
+				}
+				if text.Text != expected {
+					t.Errorf("expected content %q at index %d, got %q", expected, i, text.Text)
+				}
+			}
+		})
+	}
+
+	t.Run("warnings stack in call order", func(t *testing.T) {
+		result := withWarning(withWarning(mcp.NewToolResultText("result"), "second"), "first")
+
+		var texts []string
+		for _, content := range result.Content {
+			texts = append(texts, content.(mcp.TextContent).Text)
+		}
+		if len(texts) != 3 || texts[0] != "first" || texts[1] != "second" || texts[2] != "result" {
+			t.Errorf("expected [first second result], got %v", texts)
+		}
+	})
+
+	t.Run("error result keeps its error flag", func(t *testing.T) {
+		result := withWarning(mcp.NewToolResultError("failed"), "Warning: something")
+		if !result.IsError {
+			t.Error("expected the result to remain an error")
+		}
+	})
+}
@@ -0,0 +1,253 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/giantswarm/mcp-opsgenie/pkg/opsgenie"
+)
+
+// validateAlertQueryResult is the response of the 'validate_alert_query' tool.
+type validateAlertQueryResult struct {
+	Query  string                `json:"query"`
+	Valid  bool                  `json:"valid"`
+	Issues []opsgenie.QueryIssue `json:"issues,omitempty"`
+}
+
+// buildAlertQueryResult is the response of the 'build_alert_query' tool.
+type buildAlertQueryResult struct {
+	Query string `json:"query"`
+	Count *int   `json:"count,omitempty"`
+}
+
+func (h *opsgenieHandler) registerAlertQueryTools(s *server.MCPServer) {
+	validateAlertQueryTool := mcp.NewTool("validate_alert_query",
+		mcp.WithDescription("Checks an alert search query locally, without calling OpsGenie. Reports unknown fields, misplaced wildcards, unbalanced parentheses and other syntax errors together with their 0-based character position in the query."),
+		mcp.WithString("query",
+			mcp.Description("Search query to validate, using the same syntax as 'list_alerts'."),
+			mcp.Required(),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(validateAlertQueryTool, h.ValidateAlertQuery)
+
+	buildAlertQueryTool := mcp.NewTool("build_alert_query",
+		mcp.WithDescription("Builds a correctly quoted alert search query from structured filters, for use with 'list_alerts', 'count_alerts' or the bulk tools. All filters are combined with AND. Optionally counts the matching alerts."),
+		mcp.WithString("status",
+			mcp.Description("Optional status of the alerts."),
+			mcp.Enum("open", "closed"),
+		),
+		mcp.WithArray("priorities",
+			mcp.Description("Optional priorities, alerts with any of them match."),
+			mcp.Items(map[string]any{
+				"type": "string",
+				"enum": alertPriorities,
+			}),
+		),
+		mcp.WithArray("teams",
+			mcp.Description("Optional team names, alerts assigned to any of them match."),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("tags",
+			mcp.Description("Optional tags of the alerts, see 'tags_match'."),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("tags_match",
+			mcp.Description("Whether alerts must have 'any' (default) or 'all' of the tags."),
+			mcp.Enum("any", "all"),
+		),
+		mcp.WithString("owner",
+			mcp.Description("Optional username of the alert owner."),
+		),
+		mcp.WithBoolean("acknowledged",
+			mcp.Description("Optional acknowledgement state of the alerts."),
+		),
+		mcp.WithBoolean("snoozed",
+			mcp.Description("Optional snooze state of the alerts."),
+		),
+		mcp.WithString("source_prefix",
+			mcp.Description("Optional prefix of the alert source, such as 'prometheus'. It may only contain letters, digits, '.', '_', '@' and '-'."),
+		),
+		mcp.WithString("entity_prefix",
+			mcp.Description("Optional prefix of the alert entity, such as 'cluster-a'. It may only contain letters, digits, '.', '_', '@' and '-'."),
+		),
+		mcp.WithObject("details",
+			mcp.Description("Optional custom detail key/value pairs, e.g. {\"cluster\": \"prod eu\"}. Each pair matches alerts with a detail key and a detail value as given; OpsGenie cannot check that they belong to the same detail."),
+		),
+		withTimeWindow(),
+		mcp.WithBoolean("count",
+			mcp.Description("If true, also count the alerts matching the query. Defaults to false."),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(buildAlertQueryTool, h.BuildAlertQuery)
+}
+
+// ValidateAlertQuery checks an alert search query with the local query parser.
+func (h *opsgenieHandler) ValidateAlertQuery(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	query := request.GetString("query", "")
+	if query == "" {
+		return mcp.NewToolResultError("the 'query' parameter is required"), nil
+	}
+
+	issues := opsgenie.ValidateQuery(query)
+
+	data, err := json.Marshal(validateAlertQueryResult{
+		Query:  query,
+		Valid:  len(issues) == 0,
+		Issues: issues,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// BuildAlertQuery builds an alert search query from structured filters and optionally counts the matching alerts.
+func (h *opsgenieHandler) BuildAlertQuery(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+	var clauses []string
+
+	switch status := request.GetString("status", ""); status {
+	case "":
+	case "open", "closed":
+		clauses = append(clauses, fieldClause("status", []string{status}, "OR"))
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid status '%s', must be one of open, closed", status)), nil
+	}
+
+	priorities := request.GetStringSlice("priorities", nil)
+	for i := range priorities {
+		priority, err := parsePriority(priorities[i])
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		priorities[i] = string(priority)
+	}
+	if len(priorities) > 0 {
+		clauses = append(clauses, fieldClause("priority", priorities, "OR"))
+	}
+
+	if teams := request.GetStringSlice("teams", nil); len(teams) > 0 {
+		clauses = append(clauses, fieldClause("teams", teams, "OR"))
+	}
+
+	if tags := request.GetStringSlice("tags", nil); len(tags) > 0 {
+		switch tagsMatch := request.GetString("tags_match", "any"); tagsMatch {
+		case "any":
+			clauses = append(clauses, fieldClause("tag", tags, "OR"))
+		case "all":
+			clauses = append(clauses, fieldClause("tag", tags, "AND"))
+		default:
+			return mcp.NewToolResultError(fmt.Sprintf("invalid tags_match '%s', must be one of any, all", tagsMatch)), nil
+		}
+	}
+
+	if owner := request.GetString("owner", ""); owner != "" {
+		clauses = append(clauses, fieldClause("owner", []string{owner}, "OR"))
+	}
+
+	for _, field := range []string{"acknowledged", "snoozed"} {
+		if _, ok := arguments[field]; ok {
+			clauses = append(clauses, fmt.Sprintf("%s:%t", field, request.GetBool(field, false)))
+		}
+	}
+
+	for _, field := range []string{"source", "entity"} {
+		prefix := request.GetString(field+"_prefix", "")
+		if prefix == "" {
+			continue
+		}
+		// Wildcards do not work within quotes, so prefixes cannot be quoted
+		if opsgenie.QuoteQueryValue(prefix) != prefix {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid %s_prefix '%s', may only contain letters, digits, '.', '_', '@' and '-'", field, prefix)), nil
+		}
+		clauses = append(clauses, fmt.Sprintf("%s:%s*", field, prefix))
+	}
+
+	details, err := getStringMap(request, "details")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	keys := make([]string, 0, len(details))
+	for key := range details {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		clauses = append(clauses, fmt.Sprintf("%s AND %s", fieldClause("details.key", []string{key}, "OR"), fieldClause("details.value", []string{details[key]}, "OR")))
+	}
+
+	window, err := getTimeWindow(request, time.Now())
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if len(clauses) == 0 && window.isZero() {
+		return mcp.NewToolResultError("at least one filter is required"), nil
+	}
+
+	result := buildAlertQueryResult{
+		Query: window.apply(strings.Join(clauses, " AND ")),
+	}
+
+	if request.GetBool("count", false) {
+		count, err := h.alertClient.CountAlerts(ctx, result.Query)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to count alerts in OpsGenie: %v", err)), nil
+		}
+		result.Count = &count
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// fieldClause matches a field against one or more values combined with the given operator,
+// quoting the values as needed.
+func fieldClause(field string, values []string, operator string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, opsgenie.QuoteQueryValue(value))
+	}
+
+	if len(quoted) == 1 {
+		return fmt.Sprintf("%s:%s", field, quoted[0])
+	}
+
+	return fmt.Sprintf("%s:(%s)", field, strings.Join(quoted, " "+operator+" "))
+}
+
+// checkAlertQuery validates an alert search query with the local query parser,
+// returning an error that lists all issues if the query is invalid.
+func checkAlertQuery(query string) error {
+	issues := opsgenie.ValidateQuery(query)
+	if len(issues) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		messages = append(messages, issue.Error())
+	}
+
+	return fmt.Errorf("invalid query '%s': %s", query, strings.Join(messages, "; "))
+}
@@ -0,0 +1,190 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/giantswarm/mcp-opsgenie/pkg/opsgenie"
+)
+
+func TestFieldClause(t *testing.T) {
+	testCases := []struct {
+		name     string
+		field    string
+		values   []string
+		operator string
+		expected string
+	}{
+		{
+			name:     "single plain value",
+			field:    "status",
+			values:   []string{"open"},
+			operator: "OR",
+			expected: "status:open",
+		},
+		{
+			name:     "single multi-word value",
+			field:    "teams",
+			values:   []string{"SRE team"},
+			operator: "OR",
+			expected: `teams:"SRE team"`,
+		},
+		{
+			name:     "any of several tags",
+			field:    "tag",
+			values:   []string{"critical", "db"},
+			operator: "OR",
+			expected: "tag:(critical OR db)",
+		},
+		{
+			name:     "all of several tags",
+			field:    "tag",
+			values:   []string{"critical", "ns/pod", "a b"},
+			operator: "AND",
+			expected: `tag:(critical AND "ns/pod" AND "a b")`,
+		},
+		{
+			name:     "embedded quotes and backslashes",
+			field:    "details.value",
+			values:   []string{`say "hi"`, `C:\temp`},
+			operator: "OR",
+			expected: `details.value:("say \"hi\"" OR "C:\\temp")`,
+		},
+		{
+			name:     "keywords",
+			field:    "tag",
+			values:   []string{"AND", "OR", "NOT", "null"},
+			operator: "OR",
+			expected: `tag:("AND" OR "OR" OR "NOT" OR "null")`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := fieldClause(tc.field, tc.values, tc.operator)
+			if actual != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, actual)
+			}
+			if issues := opsgenie.ValidateQuery(actual); len(issues) > 0 {
+				t.Errorf("expected %s to be a valid query, got issues %v", actual, issues)
+			}
+		})
+	}
+}
+
+func TestBuildAlertQuery(t *testing.T) {
+	testCases := []struct {
+		name        string
+		arguments   map[string]any
+		expected    string
+		expectError string
+	}{
+		{
+			name:      "status and priorities",
+			arguments: map[string]any{"status": "open", "priorities": []any{"p1", "P2"}},
+			expected:  "status:open AND priority:(P1 OR P2)",
+		},
+		{
+			name:      "tags match any by default",
+			arguments: map[string]any{"tags": []any{"critical", "db"}},
+			expected:  "tag:(critical OR db)",
+		},
+		{
+			name:      "tags match all",
+			arguments: map[string]any{"tags": []any{"critical", "db"}, "tags_match": "all"},
+			expected:  "tag:(critical AND db)",
+		},
+		{
+			name:      "teams, owner and states",
+			arguments: map[string]any{"teams": []any{"SRE team", "infra"}, "owner": "john.smith@example.com", "acknowledged": false, "snoozed": true},
+			expected:  `teams:("SRE team" OR infra) AND owner:john.smith@example.com AND acknowledged:false AND snoozed:true`,
+		},
+		{
+			name:      "prefixes",
+			arguments: map[string]any{"source_prefix": "prom", "entity_prefix": "cluster-a"},
+			expected:  "source:prom* AND entity:cluster-a*",
+		},
+		{
+			name:      "details sorted by key",
+			arguments: map[string]any{"details": map[string]any{"region": "eu/west", "cluster": "prod"}},
+			expected:  `details.key:cluster AND details.value:prod AND details.key:region AND details.value:"eu/west"`,
+		},
+		{
+			name:      "time window",
+			arguments: map[string]any{"status": "open", "since": "2024-05-01T00:00:00Z"},
+			expected:  "(status:open) AND createdAt >= 1714521600000",
+		},
+		{
+			name:        "no filters",
+			arguments:   map[string]any{},
+			expectError: "at least one filter is required",
+		},
+		{
+			name:        "invalid status",
+			arguments:   map[string]any{"status": "pending"},
+			expectError: "invalid status 'pending'",
+		},
+		{
+			name:        "invalid priority",
+			arguments:   map[string]any{"priorities": []any{"P6"}},
+			expectError: "P6",
+		},
+		{
+			name:        "invalid tags_match",
+			arguments:   map[string]any{"tags": []any{"a"}, "tags_match": "some"},
+			expectError: "invalid tags_match 'some'",
+		},
+		{
+			name:        "prefix with reserved characters",
+			arguments:   map[string]any{"source_prefix": "ns/pod"},
+			expectError: "invalid source_prefix 'ns/pod'",
+		},
+		{
+			name:        "prefix with whitespace",
+			arguments:   map[string]any{"entity_prefix": "cluster a"},
+			expectError: "invalid entity_prefix 'cluster a'",
+		},
+	}
+
+	h := &opsgenieHandler{}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var request mcp.CallToolRequest
+			request.Params.Arguments = tc.arguments
+
+			result, err := h.BuildAlertQuery(context.Background(), request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+
+			if tc.expectError != "" {
+				if !result.IsError || !strings.Contains(text, tc.expectError) {
+					t.Fatalf("expected an error containing %q, got %s", tc.expectError, text)
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("unexpected error result: %s", text)
+			}
+
+			var built buildAlertQueryResult
+			if err := json.Unmarshal([]byte(text), &built); err != nil {
+				t.Fatalf("failed to parse result %s: %v", text, err)
+			}
+			if built.Query != tc.expected {
+				t.Errorf("expected query %s, got %s", tc.expected, built.Query)
+			}
+			if built.Count != nil {
+				t.Errorf("expected no count, got %d", *built.Count)
+			}
+			if issues := opsgenie.ValidateQuery(built.Query); len(issues) > 0 {
+				t.Errorf("expected %s to be a valid query, got issues %v", built.Query, issues)
+			}
+		})
+	}
+}
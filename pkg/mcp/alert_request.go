@@ -0,0 +1,80 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+
+	"github.com/giantswarm/mcp-opsgenie/pkg/opsgenie"
+)
+
+// requestStatusResult is the response of the 'get_request_status' tool.
+type requestStatusResult struct {
+	*alert.RequestStatusResult
+	Processed bool `json:"processed"`
+}
+
+func (h *opsgenieHandler) registerAlertRequestTools(s *server.MCPServer) {
+	getRequestStatusTool := mcp.NewTool("get_request_status",
+		mcp.WithDescription("Retrieves the outcome of an asynchronous OpsGenie alert request, such as one returned by a write tool called with 'wait' set to false. Reports 'processed: false' while OpsGenie is still processing the request."),
+		mcp.WithString("request_id",
+			mcp.Description("ID of the request, as returned in the 'requestId' field of a write tool."),
+			mcp.Required(),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(getRequestStatusTool, h.GetRequestStatus)
+}
+
+// withWait adds the 'wait' argument to an alert write tool.
+func withWait() mcp.ToolOption {
+	return mcp.WithBoolean("wait",
+		mcp.Description("If false, return the request ID as soon as OpsGenie has accepted the request instead of waiting until it has been processed. Use 'get_request_status' to look up the outcome. Defaults to true."),
+		mcp.DefaultBool(true),
+	)
+}
+
+// getAlertWriteClient returns the alert client to use for a write tool, honouring its 'wait' argument.
+func (h *opsgenieHandler) getAlertWriteClient(request mcp.CallToolRequest) *opsgenie.AlertClient {
+	if request.GetBool("wait", true) {
+		return h.alertClient
+	}
+
+	return h.alertClient.WithoutWaiting()
+}
+
+// GetRequestStatus retrieves the outcome of an asynchronous OpsGenie alert request.
+func (h *opsgenieHandler) GetRequestStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	requestID := request.GetString("request_id", "")
+	if requestID == "" {
+		return mcp.NewToolResultError("the 'request_id' parameter is required"), nil
+	}
+
+	result := requestStatusResult{Processed: true}
+
+	status, err := h.alertClient.GetRequestStatus(ctx, requestID)
+	switch {
+	case errors.Is(err, opsgenie.ErrRequestNotProcessed):
+		status = &alert.RequestStatusResult{}
+		status.RequestId = requestID
+		result.Processed = false
+	case err != nil:
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve status of request '%s' from OpsGenie: %v", requestID, err)), nil
+	}
+	result.RequestStatusResult = status
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize request status to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
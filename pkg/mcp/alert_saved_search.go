@@ -0,0 +1,239 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+// savedSearchIdentifierTypeDescription documents the 'identifier_type' argument of the saved search tools.
+const savedSearchIdentifierTypeDescription = `Type of the saved search identifier given in 'identifier'. Possible values are:
+- 'auto' (default): UUIDs are treated as saved search IDs and anything else as a name
+- 'id': the saved search ID
+- 'name': the saved search name`
+
+// uuidPattern matches UUIDs, which OpsGenie uses as saved search and user IDs.
+var uuidPattern = regexp.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+func (h *opsgenieHandler) registerAlertSavedSearchTools(s *server.MCPServer) {
+	listSavedSearchesTool := mcp.NewTool("list_saved_searches",
+		mcp.WithDescription("Retrieves the IDs and names of all saved alert searches from OpsGenie."),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(listSavedSearchesTool, h.ListSavedSearches)
+
+	getSavedSearchTool := mcp.NewTool("get_saved_search",
+		mcp.WithDescription("Retrieves a saved alert search, including its query, from OpsGenie by its name or ID."),
+		mcp.WithString("identifier",
+			mcp.Description("Name or ID of the saved search to retrieve."),
+			mcp.Required(),
+		),
+		withSavedSearchIdentifierType(),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(getSavedSearchTool, h.GetSavedSearch)
+
+	createSavedSearchTool := mcp.NewTool("create_saved_search",
+		mcp.WithDescription("Creates a saved alert search in OpsGenie, which can then be used with the 'saved_search' parameter of 'list_alerts'."),
+		mcp.WithString("name",
+			mcp.Description("Unique name of the saved search."),
+			mcp.Required(),
+		),
+		mcp.WithString("query",
+			mcp.Description("Search query of the saved search, using the same syntax as 'list_alerts'."),
+			mcp.Required(),
+		),
+		mcp.WithString("owner",
+			mcp.Description("Username or ID of the user owning the saved search."),
+			mcp.Required(),
+		),
+		mcp.WithString("description",
+			mcp.Description("Optional description of the saved search."),
+		),
+		mcp.WithArray("teams",
+			mcp.Description("Optional names of the teams the saved search is shared with."),
+			mcp.WithStringItems(),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(createSavedSearchTool, h.CreateSavedSearch)
+
+	deleteSavedSearchTool := mcp.NewTool("delete_saved_search",
+		mcp.WithDescription("Deletes a saved alert search from OpsGenie by its name or ID. The alerts matching the search are not affected."),
+		mcp.WithString("identifier",
+			mcp.Description("Name or ID of the saved search to delete."),
+			mcp.Required(),
+		),
+		withSavedSearchIdentifierType(),
+
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithDestructiveHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(deleteSavedSearchTool, h.DeleteSavedSearch)
+}
+
+// withSavedSearchIdentifierType adds the 'identifier_type' argument to a saved search tool.
+func withSavedSearchIdentifierType() mcp.ToolOption {
+	return mcp.WithString("identifier_type",
+		mcp.Description(savedSearchIdentifierTypeDescription),
+		mcp.Enum("auto", "id", "name"),
+	)
+}
+
+// ListSavedSearches retrieves all saved alert searches from OpsGenie.
+func (h *opsgenieHandler) ListSavedSearches(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	savedSearches, err := h.alertClient.ListSavedSearches(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve saved searches from OpsGenie: %v", err)), nil
+	}
+
+	data, err := json.Marshal(savedSearches)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize saved searches to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// GetSavedSearch retrieves a single saved alert search from OpsGenie by its name or ID.
+func (h *opsgenieHandler) GetSavedSearch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	identifier := request.GetString("identifier", "")
+	if identifier == "" {
+		return mcp.NewToolResultError("the 'identifier' parameter is required"), nil
+	}
+	identifierType, err := parseSavedSearchIdentifierType(identifier, request.GetString("identifier_type", "auto"))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	savedSearch, err := h.alertClient.GetSavedSearch(ctx, identifier, identifierType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve saved search '%s' from OpsGenie: %v", identifier, err)), nil
+	}
+
+	data, err := json.Marshal(savedSearch)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize saved search to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// CreateSavedSearch creates a saved alert search in OpsGenie.
+func (h *opsgenieHandler) CreateSavedSearch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	name := request.GetString("name", "")
+	if name == "" {
+		return mcp.NewToolResultError("the 'name' parameter is required"), nil
+	}
+	query := request.GetString("query", "")
+	if query == "" {
+		return mcp.NewToolResultError("the 'query' parameter is required"), nil
+	}
+	owner := request.GetString("owner", "")
+	if owner == "" {
+		return mcp.NewToolResultError("the 'owner' parameter is required"), nil
+	}
+
+	createRequest := &alert.CreateSavedSearchRequest{
+		Name:        name,
+		Query:       query,
+		Description: request.GetString("description", ""),
+	}
+	if uuidPattern.MatchString(owner) {
+		createRequest.Owner = alert.User{ID: owner}
+	} else {
+		createRequest.Owner = alert.User{Username: owner}
+	}
+	for _, team := range request.GetStringSlice("teams", nil) {
+		createRequest.Teams = append(createRequest.Teams, alert.Team{Name: team})
+	}
+
+	result, err := h.alertClient.CreateSavedSearch(ctx, createRequest)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create saved search '%s': %v", name, err)), nil
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// DeleteSavedSearch deletes a saved alert search from OpsGenie by its name or ID.
+func (h *opsgenieHandler) DeleteSavedSearch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	identifier := request.GetString("identifier", "")
+	if identifier == "" {
+		return mcp.NewToolResultError("the 'identifier' parameter is required"), nil
+	}
+	identifierType, err := parseSavedSearchIdentifierType(identifier, request.GetString("identifier_type", "auto"))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result, err := h.alertClient.DeleteSavedSearch(ctx, identifier, identifierType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to delete saved search '%s': %v", identifier, err)), nil
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// resolveSavedSearchQuery returns the query stored in the saved search with the given name or ID.
+func (h *opsgenieHandler) resolveSavedSearchQuery(ctx context.Context, identifier string) (string, error) {
+	identifierType, err := parseSavedSearchIdentifierType(identifier, "auto")
+	if err != nil {
+		return "", err
+	}
+
+	savedSearch, err := h.alertClient.GetSavedSearch(ctx, identifier, identifierType)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve saved search '%s': %w", identifier, err)
+	}
+
+	if savedSearch.Query == "" {
+		return "", fmt.Errorf("saved search '%s' has no query", identifier)
+	}
+
+	return savedSearch.Query, nil
+}
+
+// parseSavedSearchIdentifierType maps an 'identifier_type' argument to an OpsGenie saved search
+// identifier type, detecting the type from the identifier itself in 'auto' mode.
+func parseSavedSearchIdentifierType(identifier, identifierType string) (alert.SearchIdentifierType, error) {
+	switch identifierType {
+	case "id":
+		return alert.ID, nil
+	case "name":
+		return alert.NAME, nil
+	case "auto", "":
+		if uuidPattern.MatchString(identifier) {
+			return alert.ID, nil
+		}
+		return alert.NAME, nil
+	default:
+		return "", fmt.Errorf("invalid identifier_type '%s', must be one of auto, id, name", identifierType)
+	}
+}
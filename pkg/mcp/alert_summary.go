@@ -0,0 +1,357 @@
+package mcp
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+const (
+	// defaultSummaryTopValues is the default number of most frequent messages and aliases reported per group.
+	defaultSummaryTopValues = 5
+
+	// maxSummaryTopValues is the largest number of most frequent messages and aliases reported per group.
+	maxSummaryTopValues = 50
+
+	// noSummaryValue is the group value of alerts without a value for a dimension, e.g. without tags.
+	noSummaryValue = "(none)"
+)
+
+// alertSummaryDimensions lists the dimensions that alerts can be grouped by with 'summarize_alerts'.
+var alertSummaryDimensions = []string{"priority", "status", "teams", "tags", "source", "owner", "integration.name", "acknowledged"}
+
+// summarizeAlertsResult is the response of the 'summarize_alerts' tool.
+// Total counts all alerts matching the query, while the groups only cover the summarized ones,
+// which are fewer if the query matches more alerts than can be fetched.
+type summarizeAlertsResult struct {
+	Query      string         `json:"query"`
+	Total      int            `json:"total"`
+	Summarized int            `json:"summarized"`
+	Truncated  bool           `json:"truncated"`
+	GroupBy    []string       `json:"group_by"`
+	Groups     []*alertsGroup `json:"groups"`
+}
+
+// alertsGroup aggregates the alerts sharing the same values for the grouping dimensions.
+type alertsGroup struct {
+	Key                  map[string]string `json:"key"`
+	Count                int               `json:"count"`
+	TopMessages          []valueCount      `json:"top_messages"`
+	TopAliases           []valueCount      `json:"top_aliases"`
+	OldestUnacknowledged string            `json:"oldest_unacknowledged,omitempty"`
+
+	messages       map[string]int
+	aliases        map[string]int
+	oldestUnacked  *alert.Alert
+	sortableValues []string
+}
+
+// valueCount is a value together with the number of alerts having it.
+type valueCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+func (h *opsgenieHandler) registerAlertSummaryTools(s *server.MCPServer) {
+	summarizeAlertsTool := mcp.NewTool("summarize_alerts",
+		mcp.WithDescription("Summarizes the alerts matching a query without returning them: counts alerts grouped by one or more dimensions and reports, per group, the most frequent messages and aliases and the oldest open unacknowledged alert. Prefer this tool over 'list_alerts' to get an overview of many alerts."),
+		mcp.WithArray("group_by",
+			mcp.Description(fmt.Sprintf("Dimensions to group the alerts by, e.g. [\"priority\", \"teams\"]. Alerts with several teams or tags are counted in each of their groups. Possible values are: %s.", strings.Join(alertSummaryDimensions, ", "))),
+			mcp.Required(),
+			mcp.Items(map[string]any{
+				"type": "string",
+				"enum": alertSummaryDimensions,
+			}),
+		),
+		mcp.WithString("query",
+			mcp.Description("Optional search query for filtering alerts, using the same syntax as 'list_alerts'. Defaults to 'status:open'."),
+		),
+		mcp.WithString("saved_search",
+			mcp.Description("Optional name or ID of a saved search whose query is used to filter the alerts (see 'list_saved_searches'). Cannot be combined with 'query'."),
+		),
+		mcp.WithString("priority",
+			mcp.Description("Optional priority, or comma-separated list of priorities (e.g. \"P1,P2\"), that the alerts must have. It is combined with the query using AND."),
+		),
+		withTimeWindow(),
+		mcp.WithNumber("top",
+			mcp.Description(fmt.Sprintf("Number of most frequent messages and aliases to report per group, between 1 and %d. Defaults to %d.", maxSummaryTopValues, defaultSummaryTopValues)),
+			mcp.Min(1),
+			mcp.Max(maxSummaryTopValues),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(summarizeAlertsTool, h.SummarizeAlerts)
+}
+
+// SummarizeAlerts fetches all alerts matching a query and aggregates them into groups.
+// If the query matches more alerts than can be fetched, the result is marked as truncated.
+func (h *opsgenieHandler) SummarizeAlerts(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract parameters
+	groupBy := request.GetStringSlice("group_by", nil)
+	query := request.GetString("query", "")
+	savedSearch := request.GetString("saved_search", "")
+	top := request.GetInt("top", defaultSummaryTopValues)
+	window, err := getTimeWindow(request, time.Now())
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if len(groupBy) == 0 {
+		return mcp.NewToolResultError("the 'group_by' parameter is required"), nil
+	}
+	for i, dimension := range groupBy {
+		if !slices.Contains(alertSummaryDimensions, dimension) {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid dimension '%s', must be one of %s", dimension, strings.Join(alertSummaryDimensions, ", "))), nil
+		}
+		if slices.Contains(groupBy[:i], dimension) {
+			return mcp.NewToolResultError(fmt.Sprintf("the dimension '%s' is given more than once", dimension)), nil
+		}
+	}
+	if top < 1 || top > maxSummaryTopValues {
+		return mcp.NewToolResultError(fmt.Sprintf("the 'top' parameter must be between 1 and %d", maxSummaryTopValues)), nil
+	}
+	if query != "" && savedSearch != "" {
+		return mcp.NewToolResultError("the 'query' and 'saved_search' parameters cannot be combined"), nil
+	}
+
+	// Fail fast on malformed queries instead of waiting for OpsGenie to reject them
+	if err := checkAlertQuery(query); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	switch {
+	case savedSearch != "":
+		// Resolve the saved search to its stored query
+		if query, err = h.resolveSavedSearchQuery(ctx, savedSearch); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	case query == "":
+		// Default to open alerts if no query is provided
+		query = "status:open"
+	}
+
+	// Narrow down the query to the requested priorities
+	if query, err = withPriorityFilter(query, request.GetString("priority", "")); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	query = window.apply(query)
+
+	// Count the alerts first, as fetching them stops at the maximum number of alerts OpsGenie can page through
+	total, err := h.alertClient.CountAlerts(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to count alerts in OpsGenie: %v", err)), nil
+	}
+
+	// Fetch all alerts from OpsGenie matching the query, oldest first
+	alerts, err := h.alertClient.ListAlerts(ctx, query, alert.CreatedAt, alert.Asc)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve alerts from OpsGenie: %v", err)), nil
+	}
+
+	// Team responders of listed alerts may only carry the team ID
+	var teamNames map[string]string
+	if slices.Contains(groupBy, "teams") {
+		if teamNames, err = h.getTeamNames(ctx, alerts); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve teams from OpsGenie: %v", err)), nil
+		}
+	}
+
+	result, err := summarizeAlerts(query, total, alerts, groupBy, top, teamNames)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize result to JSON: %v", err)), nil
+	}
+
+	var warning string
+	if result.Truncated {
+		warning = fmt.Sprintf("Warning: the query matches %d alerts, but only the %d oldest were summarized, so the group counts are incomplete. Narrow down the query, e.g. with 'since', to summarize all matching alerts.", result.Total, result.Summarized)
+	}
+
+	return withWarning(mcp.NewToolResultText(string(data)), warning), nil
+}
+
+// summarizeAlerts groups the alerts fetched for a query by the given dimensions, largest groups first.
+// The total is the number of alerts matching the query, which exceeds the number of fetched alerts
+// if the alerts could not all be fetched.
+func summarizeAlerts(query string, total int, alerts []alert.Alert, groupBy []string, top int, teamNames map[string]string) (summarizeAlertsResult, error) {
+	result := summarizeAlertsResult{
+		Query:      query,
+		Total:      max(total, len(alerts)),
+		Summarized: len(alerts),
+		Truncated:  total > len(alerts),
+		GroupBy:    groupBy,
+		Groups:     []*alertsGroup{},
+	}
+	groups := make(map[string]*alertsGroup)
+	for i := range alerts {
+		a := &alerts[i]
+
+		dimensionValues := make([][]string, 0, len(groupBy))
+		for _, dimension := range groupBy {
+			dimensionValues = append(dimensionValues, alertDimensionValues(a, dimension, teamNames))
+		}
+
+		for _, values := range combinations(dimensionValues) {
+			id := strings.Join(values, "\x00")
+			group, ok := groups[id]
+			if !ok {
+				group = &alertsGroup{
+					Key:            make(map[string]string, len(groupBy)),
+					messages:       make(map[string]int),
+					aliases:        make(map[string]int),
+					sortableValues: values,
+				}
+				for j, dimension := range groupBy {
+					group.Key[dimension] = values[j]
+				}
+				groups[id] = group
+				result.Groups = append(result.Groups, group)
+			}
+
+			group.Count++
+			group.messages[a.Message]++
+			if a.Alias != "" {
+				group.aliases[a.Alias]++
+			}
+			if a.Status == "open" && !a.Acknowledged && (group.oldestUnacked == nil || a.CreatedAt.Before(group.oldestUnacked.CreatedAt)) {
+				group.oldestUnacked = a
+			}
+		}
+	}
+
+	for _, group := range result.Groups {
+		group.TopMessages = topValues(group.messages, top)
+		group.TopAliases = topValues(group.aliases, top)
+		if group.oldestUnacked != nil {
+			line, err := alertSummaryLine(group.oldestUnacked)
+			if err != nil {
+				return summarizeAlertsResult{}, err
+			}
+			group.OldestUnacknowledged = line
+		}
+	}
+
+	// Largest groups first
+	slices.SortFunc(result.Groups, func(a, b *alertsGroup) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), slices.Compare(a.sortableValues, b.sortableValues))
+	})
+
+	return result, nil
+}
+
+// getTeamNames maps the IDs of the team responders of the given alerts to team names.
+// Teams are only listed if a team responder lacks its name.
+func (h *opsgenieHandler) getTeamNames(ctx context.Context, alerts []alert.Alert) (map[string]string, error) {
+	names := make(map[string]string)
+	complete := true
+	for _, a := range alerts {
+		for _, responder := range a.Responders {
+			if responder.Type != alert.TeamResponder {
+				continue
+			}
+			if responder.Name == "" {
+				complete = false
+				continue
+			}
+			names[responder.Id] = responder.Name
+		}
+	}
+	if complete {
+		return names, nil
+	}
+
+	teams, err := h.teamClient.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range teams {
+		names[t.Id] = t.Name
+	}
+
+	return names, nil
+}
+
+// alertDimensionValues returns the values of an alert for a grouping dimension.
+// Alerts without a value are reported as noSummaryValue.
+func alertDimensionValues(a *alert.Alert, dimension string, teamNames map[string]string) []string {
+	var values []string
+	switch dimension {
+	case "priority":
+		values = []string{string(a.Priority)}
+	case "status":
+		values = []string{a.Status}
+	case "teams":
+		for _, responder := range a.Responders {
+			if responder.Type != alert.TeamResponder {
+				continue
+			}
+			name := cmp.Or(responder.Name, teamNames[responder.Id], responder.Id)
+			if !slices.Contains(values, name) {
+				values = append(values, name)
+			}
+		}
+	case "tags":
+		values = slices.Compact(slices.Sorted(slices.Values(a.Tags)))
+	case "source":
+		values = []string{a.Source}
+	case "owner":
+		values = []string{a.Owner}
+	case "integration.name":
+		values = []string{a.Integration.Name}
+	case "acknowledged":
+		values = []string{strconv.FormatBool(a.Acknowledged)}
+	}
+
+	values = slices.DeleteFunc(values, func(value string) bool { return value == "" })
+	if len(values) == 0 {
+		return []string{noSummaryValue}
+	}
+
+	return values
+}
+
+// combinations returns every combination of one value per dimension.
+func combinations(dimensionValues [][]string) [][]string {
+	result := [][]string{{}}
+	for _, values := range dimensionValues {
+		next := make([][]string, 0, len(result)*len(values))
+		for _, prefix := range result {
+			for _, value := range values {
+				next = append(next, append(slices.Clone(prefix), value))
+			}
+		}
+		result = next
+	}
+
+	return result
+}
+
+// topValues returns the n most frequent values, most frequent first.
+func topValues(counts map[string]int, n int) []valueCount {
+	values := make([]valueCount, 0, len(counts))
+	for value, count := range counts {
+		values = append(values, valueCount{Value: value, Count: count})
+	}
+
+	slices.SortFunc(values, func(a, b valueCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Value, b.Value))
+	})
+
+	return values[:min(n, len(values))]
+}
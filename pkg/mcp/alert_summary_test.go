@@ -0,0 +1,335 @@
+package mcp
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+// summaryFixture returns a small set of alerts covering acknowledged, closed and unassigned alerts.
+func summaryFixture() []alert.Alert {
+	base := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	return []alert.Alert{
+		{
+			Id:         "a1",
+			TinyID:     "1",
+			Message:    "disk full",
+			Alias:      "disk-db1",
+			Status:     "open",
+			Priority:   alert.P1,
+			Tags:       []string{"storage", "db"},
+			Source:     "prometheus",
+			Responders: []alert.Responder{{Type: alert.TeamResponder, Id: "t1"}},
+			CreatedAt:  base.Add(time.Hour),
+		},
+		{
+			Id:           "a2",
+			TinyID:       "2",
+			Message:      "disk full",
+			Alias:        "disk-db2",
+			Status:       "open",
+			Acknowledged: true,
+			Priority:     alert.P1,
+			Tags:         []string{"db"},
+			Source:       "prometheus",
+			Responders: []alert.Responder{
+				{Type: alert.TeamResponder, Id: "t1"},
+				{Type: alert.TeamResponder, Id: "t2", Name: "DBA"},
+				{Type: alert.UserResponder, Id: "u1", Username: "jane@example.com"},
+			},
+			CreatedAt: base,
+		},
+		{
+			Id:        "a3",
+			TinyID:    "3",
+			Message:   "cpu high",
+			Status:    "closed",
+			Priority:  alert.P2,
+			CreatedAt: base.Add(-time.Hour),
+		},
+		{
+			Id:          "a4",
+			TinyID:      "4",
+			Message:     "cpu high",
+			Alias:       "cpu-web",
+			Status:      "open",
+			Priority:    alert.P1,
+			Tags:        []string{"web"},
+			Owner:       "jane@example.com",
+			Integration: alert.Integration{Name: "Prometheus"},
+			CreatedAt:   base.Add(2 * time.Hour),
+		},
+	}
+}
+
+// formatGroups renders the keys and counts of groups in order, e.g. "priority=P1:3".
+func formatGroups(groupBy []string, groups []*alertsGroup) []string {
+	formatted := make([]string, 0, len(groups))
+	for _, group := range groups {
+		parts := make([]string, 0, len(groupBy))
+		for _, dimension := range groupBy {
+			parts = append(parts, fmt.Sprintf("%s=%s", dimension, group.Key[dimension]))
+		}
+		formatted = append(formatted, fmt.Sprintf("%s:%d", strings.Join(parts, ","), group.Count))
+	}
+
+	return formatted
+}
+
+func TestSummarizeAlertsGroups(t *testing.T) {
+	testCases := []struct {
+		name     string
+		groupBy  []string
+		expected []string
+	}{
+		{
+			name:     "priority",
+			groupBy:  []string{"priority"},
+			expected: []string{"priority=P1:3", "priority=P2:1"},
+		},
+		{
+			name:     "status",
+			groupBy:  []string{"status"},
+			expected: []string{"status=open:3", "status=closed:1"},
+		},
+		{
+			name:     "acknowledged",
+			groupBy:  []string{"acknowledged"},
+			expected: []string{"acknowledged=false:3", "acknowledged=true:1"},
+		},
+		{
+			name:     "tags count alerts in each of their groups",
+			groupBy:  []string{"tags"},
+			expected: []string{"tags=db:2", "tags=(none):1", "tags=storage:1", "tags=web:1"},
+		},
+		{
+			name:     "team names are resolved and user responders ignored",
+			groupBy:  []string{"teams"},
+			expected: []string{"teams=(none):2", "teams=SRE:2", "teams=DBA:1"},
+		},
+		{
+			name:     "source",
+			groupBy:  []string{"source"},
+			expected: []string{"source=(none):2", "source=prometheus:2"},
+		},
+		{
+			name:     "owner",
+			groupBy:  []string{"owner"},
+			expected: []string{"owner=(none):3", "owner=jane@example.com:1"},
+		},
+		{
+			name:     "integration name",
+			groupBy:  []string{"integration.name"},
+			expected: []string{"integration.name=(none):3", "integration.name=Prometheus:1"},
+		},
+		{
+			name:    "combinations of several dimensions",
+			groupBy: []string{"teams", "priority"},
+			expected: []string{
+				"teams=SRE,priority=P1:2",
+				"teams=(none),priority=P1:1",
+				"teams=(none),priority=P2:1",
+				"teams=DBA,priority=P1:1",
+			},
+		},
+		{
+			name:    "combinations with multi-valued dimensions",
+			groupBy: []string{"priority", "tags"},
+			expected: []string{
+				"priority=P1,tags=db:2",
+				"priority=P1,tags=storage:1",
+				"priority=P1,tags=web:1",
+				"priority=P2,tags=(none):1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			alerts := summaryFixture()
+			result, err := summarizeAlerts("status:open", len(alerts), alerts, tc.groupBy, defaultSummaryTopValues, map[string]string{"t1": "SRE"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if actual := formatGroups(tc.groupBy, result.Groups); !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected groups %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSummarizeAlertsGroupDetails(t *testing.T) {
+	alerts := summaryFixture()
+	result, err := summarizeAlerts("status:open", len(alerts), alerts, []string{"priority"}, 2, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Groups) != 2 {
+		t.Fatalf("expected 2 groups, got %v", formatGroups(result.GroupBy, result.Groups))
+	}
+
+	p1, p2 := result.Groups[0], result.Groups[1]
+
+	// Most frequent first, ties broken by value, limited to the requested number
+	expectedMessages := []valueCount{{Value: "disk full", Count: 2}, {Value: "cpu high", Count: 1}}
+	if !slices.Equal(p1.TopMessages, expectedMessages) {
+		t.Errorf("expected top messages %v, got %v", expectedMessages, p1.TopMessages)
+	}
+	expectedAliases := []valueCount{{Value: "cpu-web", Count: 1}, {Value: "disk-db1", Count: 1}}
+	if !slices.Equal(p1.TopAliases, expectedAliases) {
+		t.Errorf("expected top aliases %v, got %v", expectedAliases, p1.TopAliases)
+	}
+
+	// The older alert a2 is acknowledged, so a1 is the oldest open unacknowledged alert
+	if !strings.HasPrefix(p1.OldestUnacknowledged, "#1 P1 open ") || !strings.HasSuffix(p1.OldestUnacknowledged, "id=a1") {
+		t.Errorf("expected the oldest unacknowledged alert to be a1, got %q", p1.OldestUnacknowledged)
+	}
+
+	// Closed alerts are never reported as unacknowledged, and alerts without alias have no top aliases
+	if p2.OldestUnacknowledged != "" {
+		t.Errorf("expected no oldest unacknowledged alert for closed alerts, got %q", p2.OldestUnacknowledged)
+	}
+	if len(p2.TopAliases) != 0 {
+		t.Errorf("expected no top aliases, got %v", p2.TopAliases)
+	}
+}
+
+func TestSummarizeAlertsTotals(t *testing.T) {
+	testCases := []struct {
+		name               string
+		total              int
+		expectedTotal      int
+		expectedSummarized int
+		expectedTruncated  bool
+	}{
+		{
+			name:               "all alerts fetched",
+			total:              4,
+			expectedTotal:      4,
+			expectedSummarized: 4,
+		},
+		{
+			name:               "more alerts match than were fetched",
+			total:              25000,
+			expectedTotal:      25000,
+			expectedSummarized: 4,
+			expectedTruncated:  true,
+		},
+		{
+			name:               "alerts created between counting and fetching",
+			total:              3,
+			expectedTotal:      4,
+			expectedSummarized: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := summarizeAlerts("status:open", tc.total, summaryFixture(), []string{"priority"}, defaultSummaryTopValues, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Total != tc.expectedTotal || result.Summarized != tc.expectedSummarized || result.Truncated != tc.expectedTruncated {
+				t.Errorf("expected total %d, summarized %d and truncated %t, got %d, %d and %t",
+					tc.expectedTotal, tc.expectedSummarized, tc.expectedTruncated,
+					result.Total, result.Summarized, result.Truncated)
+			}
+		})
+	}
+
+	t.Run("no alerts", func(t *testing.T) {
+		result, err := summarizeAlerts("status:open", 0, nil, []string{"priority"}, defaultSummaryTopValues, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Total != 0 || result.Truncated || result.Groups == nil || len(result.Groups) != 0 {
+			t.Errorf("expected an empty, non-nil list of groups, got %+v", result)
+		}
+	})
+}
+
+func TestAlertDimensionValues(t *testing.T) {
+	a := &alert.Alert{
+		Priority: alert.P3,
+		Tags:     []string{"b", "a", "b"},
+		Responders: []alert.Responder{
+			{Type: alert.TeamResponder, Id: "t1"},
+			{Type: alert.TeamResponder, Id: "t2", Name: "DBA"},
+			{Type: alert.TeamResponder, Id: "t3"},
+			{Type: alert.TeamResponder, Id: "t2", Name: "DBA"},
+		},
+	}
+
+	testCases := []struct {
+		dimension string
+		expected  []string
+	}{
+		{dimension: "priority", expected: []string{"P3"}},
+		{dimension: "status", expected: []string{noSummaryValue}},
+		{dimension: "tags", expected: []string{"a", "b"}},
+		{dimension: "teams", expected: []string{"SRE", "DBA", "t3"}},
+		{dimension: "acknowledged", expected: []string{"false"}},
+		{dimension: "integration.name", expected: []string{noSummaryValue}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.dimension, func(t *testing.T) {
+			actual := alertDimensionValues(a, tc.dimension, map[string]string{"t1": "SRE"})
+			if !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	actual := combinations([][]string{{"P1"}, {"a", "b"}, {"x", "y"}})
+	expected := [][]string{{"P1", "a", "x"}, {"P1", "a", "y"}, {"P1", "b", "x"}, {"P1", "b", "y"}}
+
+	if !slices.EqualFunc(actual, expected, slices.Equal[[]string]) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestTopValues(t *testing.T) {
+	testCases := []struct {
+		name     string
+		counts   map[string]int
+		n        int
+		expected []valueCount
+	}{
+		{
+			name:     "most frequent first with ties by value",
+			counts:   map[string]int{"a": 1, "c": 3, "b": 3},
+			n:        5,
+			expected: []valueCount{{Value: "b", Count: 3}, {Value: "c", Count: 3}, {Value: "a", Count: 1}},
+		},
+		{
+			name:     "limited to n",
+			counts:   map[string]int{"a": 1, "c": 3, "b": 3},
+			n:        1,
+			expected: []valueCount{{Value: "b", Count: 3}},
+		},
+		{
+			name:     "no values",
+			counts:   map[string]int{},
+			n:        5,
+			expected: []valueCount{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := topValues(tc.counts, tc.n); !slices.Equal(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+var (
+	// listedAlertFields lists the fields that can be selected with the 'fields' argument of 'list_alerts'.
+	listedAlertFields = jsonFieldNames(reflect.TypeOf(alert.Alert{}))
+
+	// alertFields lists the fields that can be selected with the 'fields' argument of 'get_alert'.
+	alertFields = jsonFieldNames(reflect.TypeOf(alert.GetAlertResult{}))
+)
+
+// alertView controls how the alert tools render alerts: either as JSON,
+// optionally restricted to a set of fields, or as one summary line per alert.
+type alertView struct {
+	fields  []string
+	summary bool
+}
+
+// alertSummary holds the fields of an alert that are shown in its summary line.
+type alertSummary struct {
+	Id           string    `json:"id"`
+	TinyId       string    `json:"tinyId"`
+	Message      string    `json:"message"`
+	Status       string    `json:"status"`
+	Acknowledged bool      `json:"acknowledged"`
+	Snoozed      bool      `json:"snoozed"`
+	Count        int       `json:"count"`
+	CreatedAt    time.Time `json:"createdAt"`
+	Priority     string    `json:"priority"`
+}
+
+// withAlertFields adds the 'fields' argument to an alert tool.
+func withAlertFields(fields []string) mcp.ToolOption {
+	return mcp.WithArray("fields",
+		mcp.Description(fmt.Sprintf("Optional list of alert fields to return, e.g. [\"id\", \"tinyId\", \"message\", \"priority\", \"status\", \"createdAt\", \"tags\"]. Returns all fields if omitted. Possible values are: %s.", strings.Join(fields, ", "))),
+		mcp.WithStringItems(),
+	)
+}
+
+// withAlertFormat adds the 'format' argument to an alert tool.
+func withAlertFormat() mcp.ToolOption {
+	return mcp.WithString("format",
+		mcp.Description("Output format. 'json' (default) returns the alerts as JSON, 'summary' returns one short line per alert with its tiny ID, priority, state, message, creation time, count and ID. Cannot be combined with 'fields'."),
+		mcp.Enum("json", "summary"),
+	)
+}
+
+// getAlertView extracts the 'fields' and 'format' arguments of an alert tool.
+// Fields are validated against the given list of valid field names.
+func getAlertView(request mcp.CallToolRequest, validFields []string) (alertView, error) {
+	var view alertView
+
+	// Accept a comma-separated string as well as a list of fields
+	if raw, ok := request.GetArguments()["fields"].(string); ok {
+		for _, field := range strings.Split(raw, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				view.fields = append(view.fields, field)
+			}
+		}
+	} else {
+		view.fields = request.GetStringSlice("fields", nil)
+	}
+
+	for _, field := range view.fields {
+		if !slices.Contains(validFields, field) {
+			return alertView{}, fmt.Errorf("invalid field '%s', must be one of %s", field, strings.Join(validFields, ", "))
+		}
+	}
+
+	switch format := request.GetString("format", "json"); format {
+	case "json", "":
+	case "summary":
+		if len(view.fields) > 0 {
+			return alertView{}, fmt.Errorf("the 'fields' parameter cannot be combined with format 'summary'")
+		}
+		view.summary = true
+	default:
+		return alertView{}, fmt.Errorf("invalid format '%s', must be one of json, summary", format)
+	}
+
+	return view, nil
+}
+
+// project returns the selected fields of an alert, or the alert itself if no fields are selected.
+// Fields are read from the struct rather than its JSON, so that fields omitted from the JSON when
+// empty, such as 'acknowledged' or 'count', are still returned with their zero value.
+func (v alertView) project(a any) (any, error) {
+	if len(v.fields) == 0 {
+		return a, nil
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(a))
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot select fields of %T", a)
+	}
+
+	projected := make(map[string]any, len(v.fields))
+	for i := range value.NumField() {
+		name, ok := jsonFieldName(value.Type().Field(i))
+		if ok && slices.Contains(v.fields, name) {
+			projected[name] = value.Field(i).Interface()
+		}
+	}
+
+	return projected, nil
+}
+
+// alertSummaryLine renders an alert as a single line of text.
+func alertSummaryLine(a any) (string, error) {
+	var summary alertSummary
+	if err := remarshal(a, &summary); err != nil {
+		return "", err
+	}
+
+	state := summary.Status
+	if summary.Acknowledged {
+		state += "/acked"
+	}
+	if summary.Snoozed {
+		state += "/snoozed"
+	}
+
+	return fmt.Sprintf("#%s %s %s %q created=%s count=%d id=%s",
+		summary.TinyId,
+		summary.Priority,
+		state,
+		summary.Message,
+		summary.CreatedAt.UTC().Format(time.RFC3339),
+		summary.Count,
+		summary.Id,
+	), nil
+}
+
+// remarshal converts a value into another type with the same JSON representation.
+func remarshal(from, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return fmt.Errorf("failed to serialize alert to JSON: %w", err)
+	}
+
+	if err := json.Unmarshal(data, to); err != nil {
+		return fmt.Errorf("failed to deserialize alert from JSON: %w", err)
+	}
+
+	return nil
+}
+
+// jsonFieldNames returns the JSON names of the fields of a struct type.
+// Embedded structs, such as the result metadata of the OpsGenie SDK, are skipped.
+func jsonFieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := range t.NumField() {
+		if name, ok := jsonFieldName(t.Field(i)); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// jsonFieldName returns the JSON name of a struct field, and false if the field
+// is embedded, unexported or not serialized.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if field.Anonymous || !field.IsExported() {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, true
+}
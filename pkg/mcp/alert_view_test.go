@@ -0,0 +1,170 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+func TestAlertViewProject(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		fields   []string
+		alert    any
+		expected string
+	}{
+		{
+			name:     "false and zero values of omitempty fields are kept",
+			fields:   []string{"acknowledged", "snoozed", "isSeen", "count"},
+			alert:    alert.Alert{Id: "a1", Message: "disk full"},
+			expected: `{"acknowledged":false,"count":0,"isSeen":false,"snoozed":false}`,
+		},
+		{
+			name:     "set values",
+			fields:   []string{"id", "acknowledged", "priority", "createdAt", "tags"},
+			alert:    alert.Alert{Id: "a1", Acknowledged: true, Priority: alert.P1, CreatedAt: createdAt, Tags: []string{"db"}},
+			expected: `{"acknowledged":true,"createdAt":"2024-05-01T08:00:00Z","id":"a1","priority":"P1","tags":["db"]}`,
+		},
+		{
+			name:     "empty string field",
+			fields:   []string{"owner"},
+			alert:    alert.Alert{Id: "a1"},
+			expected: `{"owner":""}`,
+		},
+		{
+			name:     "pointer to get alert result",
+			fields:   []string{"id", "acknowledged"},
+			alert:    &alert.GetAlertResult{Id: "a1"},
+			expected: `{"acknowledged":false,"id":"a1"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projected, err := alertView{fields: tc.fields}.project(tc.alert)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			data, err := json.Marshal(projected)
+			if err != nil {
+				t.Fatalf("failed to serialize projection: %v", err)
+			}
+			if string(data) != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, data)
+			}
+		})
+	}
+
+	t.Run("no fields returns the alert unchanged", func(t *testing.T) {
+		a := alert.Alert{Id: "a1"}
+		projected, err := alertView{}.project(a)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if projected.(alert.Alert).Id != "a1" {
+			t.Errorf("expected the alert itself, got %+v", projected)
+		}
+	})
+}
+
+func TestGetAlertView(t *testing.T) {
+	testCases := []struct {
+		name            string
+		arguments       map[string]any
+		expectedFields  []string
+		expectedSummary bool
+		expectError     bool
+	}{
+		{
+			name:      "defaults",
+			arguments: map[string]any{},
+		},
+		{
+			name:           "list of fields",
+			arguments:      map[string]any{"fields": []any{"id", "acknowledged"}},
+			expectedFields: []string{"id", "acknowledged"},
+		},
+		{
+			name:           "comma-separated fields",
+			arguments:      map[string]any{"fields": "id, acknowledged,"},
+			expectedFields: []string{"id", "acknowledged"},
+		},
+		{
+			name:            "summary format",
+			arguments:       map[string]any{"format": "summary"},
+			expectedSummary: true,
+		},
+		{
+			name:        "unknown field",
+			arguments:   map[string]any{"fields": []any{"acked"}},
+			expectError: true,
+		},
+		{
+			name:        "fields with summary format",
+			arguments:   map[string]any{"fields": []any{"id"}, "format": "summary"},
+			expectError: true,
+		},
+		{
+			name:        "unknown format",
+			arguments:   map[string]any{"format": "yaml"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var request mcp.CallToolRequest
+			request.Params.Arguments = tc.arguments
+
+			view, err := getAlertView(request, listedAlertFields)
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got view %+v", view)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(view.fields) != len(tc.expectedFields) {
+				t.Fatalf("expected fields %v, got %v", tc.expectedFields, view.fields)
+			}
+			for i := range view.fields {
+				if view.fields[i] != tc.expectedFields[i] {
+					t.Errorf("expected fields %v, got %v", tc.expectedFields, view.fields)
+				}
+			}
+			if view.summary != tc.expectedSummary {
+				t.Errorf("expected summary %t, got %t", tc.expectedSummary, view.summary)
+			}
+		})
+	}
+}
+
+func TestAlertSummaryLine(t *testing.T) {
+	a := alert.Alert{
+		Id:           "a1",
+		TinyID:       "42",
+		Message:      "disk full",
+		Status:       "open",
+		Acknowledged: true,
+		Count:        3,
+		CreatedAt:    time.Date(2024, 5, 1, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+		Priority:     alert.P2,
+	}
+
+	line, err := alertSummaryLine(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `#42 P2 open/acked "disk full" created=2024-05-01T08:00:00Z count=3 id=a1`; line != expected {
+		t.Errorf("expected %s, got %s", expected, line)
+	}
+}
@@ -0,0 +1,265 @@
+package mcp
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
+)
+
+// alertPriorities lists the priority values accepted by OpsGenie, from highest to lowest.
+var alertPriorities = []string{
+	string(alert.P1),
+	string(alert.P2),
+	string(alert.P3),
+	string(alert.P4),
+	string(alert.P5),
+}
+
+// alertSortFields lists the fields that alerts can be sorted by in 'list_alerts'.
+// The SDK constant alert.LastOccurredAt is misspelled, so lastOccurredAt is spelled out.
+var alertSortFields = []string{
+	string(alert.CreatedAt),
+	string(alert.UpdatedAt),
+	string(alert.TinyId),
+	string(alert.Alias),
+	string(alert.Message),
+	string(alert.Status),
+	string(alert.Acknowledged),
+	string(alert.IsSeen),
+	string(alert.Snoozed),
+	string(alert.Count),
+	"lastOccurredAt",
+	string(alert.Source),
+	string(alert.Owner),
+	string(alert.IntegrationName),
+	string(alert.IntegrationType),
+	string(alert.AckTime),
+	string(alert.CloseTime),
+}
+
+// responderSchema is the JSON schema of a single responder argument item.
+var responderSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"type": map[string]any{
+			"type":        "string",
+			"enum":        []string{"team", "user", "escalation", "schedule"},
+			"description": "Type of the responder.",
+		},
+		"id": map[string]any{
+			"type":        "string",
+			"description": "ID of the responder.",
+		},
+		"name": map[string]any{
+			"type":        "string",
+			"description": "Name of the team, escalation or schedule.",
+		},
+		"username": map[string]any{
+			"type":        "string",
+			"description": "Username of the user.",
+		},
+	},
+	"required": []string{"type"},
+}
+
+// parsePriority validates a priority argument. An empty value is returned unchanged
+// so that OpsGenie applies its default priority.
+func parsePriority(value string) (alert.Priority, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	priority := strings.ToUpper(value)
+	if !slices.Contains(alertPriorities, priority) {
+		return "", fmt.Errorf("invalid priority '%s', must be one of %s", value, strings.Join(alertPriorities, ", "))
+	}
+
+	return alert.Priority(priority), nil
+}
+
+// parseSortField validates a sort field argument. An empty value sorts alerts by creation time.
+func parseSortField(value string) (alert.SortField, error) {
+	if value == "" {
+		return alert.CreatedAt, nil
+	}
+
+	if !slices.Contains(alertSortFields, value) {
+		return "", fmt.Errorf("invalid sort field '%s', must be one of %s", value, strings.Join(alertSortFields, ", "))
+	}
+
+	return alert.SortField(value), nil
+}
+
+// parseOrder validates a sorting order argument. An empty value returns the given default order.
+func parseOrder(value string, defaultOrder alert.Order) (alert.Order, error) {
+	switch order := alert.Order(strings.ToLower(value)); order {
+	case "":
+		return defaultOrder, nil
+	case alert.Asc, alert.Desc:
+		return order, nil
+	default:
+		return "", fmt.Errorf("invalid order '%s', must be one of asc, desc", value)
+	}
+}
+
+// withPriorityFilter merges a comma-separated list of priorities into an alert query,
+// matching alerts with any of the priorities. An empty list leaves the query unchanged.
+func withPriorityFilter(query, priorities string) (string, error) {
+	var values []string
+	for _, value := range strings.Split(priorities, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		priority, err := parsePriority(value)
+		if err != nil {
+			return "", err
+		}
+		if !slices.Contains(values, string(priority)) {
+			values = append(values, string(priority))
+		}
+	}
+
+	var filter string
+	switch len(values) {
+	case 0:
+		return query, nil
+	case 1:
+		filter = "priority:" + values[0]
+	default:
+		filter = fmt.Sprintf("priority:(%s)", strings.Join(values, " OR "))
+	}
+
+	return andQuery(query, filter), nil
+}
+
+// andQuery combines an alert query with an additional clause using AND.
+// An empty query returns the clause on its own.
+func andQuery(query, clause string) string {
+	if query == "" {
+		return clause
+	}
+
+	return fmt.Sprintf("(%s) AND %s", query, clause)
+}
+
+// getStringMap extracts an object argument whose values are converted to strings.
+// It returns nil if the argument is not present.
+func getStringMap(request mcp.CallToolRequest, key string) (map[string]string, error) {
+	raw, ok := request.GetArguments()[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	object, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("the '%s' parameter must be an object", key)
+	}
+
+	result := make(map[string]string, len(object))
+	for k, v := range object {
+		switch value := v.(type) {
+		case string:
+			result[k] = value
+		default:
+			result[k] = fmt.Sprint(value)
+		}
+	}
+
+	return result, nil
+}
+
+// getResponders extracts a list of responders from the given argument.
+// Users are identified by username or ID; teams, escalations and schedules by name or ID.
+func getResponders(request mcp.CallToolRequest, key string) ([]alert.Responder, error) {
+	raw, ok := request.GetArguments()[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("the '%s' parameter must be an array", key)
+	}
+
+	responders := make([]alert.Responder, 0, len(items))
+	for i, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be an object", key, i)
+		}
+
+		responder, err := parseResponder(object)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", key, i, err)
+		}
+		responders = append(responders, responder)
+	}
+
+	return responders, nil
+}
+
+// parseResponder converts a single responder object into an OpsGenie responder.
+func parseResponder(object map[string]any) (alert.Responder, error) {
+	responderType, _ := object["type"].(string)
+	id, _ := object["id"].(string)
+	name, _ := object["name"].(string)
+	username, _ := object["username"].(string)
+
+	responder := alert.Responder{
+		Type: alert.ResponderType(responderType),
+		Id:   id,
+	}
+
+	switch responder.Type {
+	case alert.UserResponder:
+		if id == "" && username == "" {
+			return alert.Responder{}, fmt.Errorf("user responder requires 'username' or 'id'")
+		}
+		responder.Username = username
+	case alert.TeamResponder, alert.EscalationResponder, alert.ScheduleResponder:
+		if id == "" && name == "" {
+			return alert.Responder{}, fmt.Errorf("%s responder requires 'name' or 'id'", responderType)
+		}
+		responder.Name = name
+	default:
+		return alert.Responder{}, fmt.Errorf("invalid responder type '%s', must be one of team, user, escalation, schedule", responderType)
+	}
+
+	return responder, nil
+}
+
+// getNamedQueries extracts a list of named alert queries from the given argument.
+func getNamedQueries(request mcp.CallToolRequest, key string) ([]namedAlertCount, error) {
+	raw, ok := request.GetArguments()[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("the '%s' parameter must be an array", key)
+	}
+
+	queries := make([]namedAlertCount, 0, len(items))
+	for i, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be an object", key, i)
+		}
+
+		name, _ := object["name"].(string)
+		query, _ := object["query"].(string)
+		if name == "" || query == "" {
+			return nil, fmt.Errorf("%s[%d] requires 'name' and 'query'", key, i)
+		}
+
+		queries = append(queries, namedAlertCount{Name: name, Query: query})
+	}
+
+	return queries, nil
+}
@@ -0,0 +1,58 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func (h *opsgenieHandler) registerEscalationTools(s *server.MCPServer) {
+	listEscalationsTool := mcp.NewTool("list_escalations",
+		mcp.WithDescription("Retrieve a list of all escalation policies from OpsGenie. Use it to find the escalation to pass to 'escalate_alert'."),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+	s.AddTool(listEscalationsTool, h.ListEscalations)
+}
+
+// escalationSummary is the condensed representation of an escalation policy
+// returned by the 'list_escalations' tool.
+type escalationSummary struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	OwnerTeam   string `json:"ownerTeam,omitempty"`
+}
+
+// ListEscalations retrieves all escalation policies from OpsGenie.
+func (h *opsgenieHandler) ListEscalations(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	escalations, err := h.escalationClient.ListEscalations(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve escalations from OpsGenie: %v", err)), nil
+	}
+
+	summaries := make([]escalationSummary, 0, len(escalations))
+	for _, e := range escalations {
+		summary := escalationSummary{
+			Id:          e.Id,
+			Name:        e.Name,
+			Description: e.Description,
+		}
+		if e.OwnerTeam != nil {
+			summary.OwnerTeam = e.OwnerTeam.Name
+		}
+		summaries = append(summaries, summary)
+	}
+
+	data, err := json.Marshal(summaries)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to serialize escalations to JSON: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
@@ -4,6 +4,7 @@ package mcp
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/mark3labs/mcp-go/server"
 
@@ -13,27 +14,47 @@ import (
 // opsgenieHandler handles MCP tool requests for OpsGenie operations.
 // It encapsulates the OpsGenie alert client and provides methods to interact with alerts.
 type opsgenieHandler struct {
-	alertClient     *opsgenie.AlertClient
-	heartbeatClient *opsgenie.HeartbeatClient
-	teamClient      *opsgenie.TeamClient
+	alertClient      *opsgenie.AlertClient
+	escalationClient *opsgenie.EscalationClient
+	heartbeatClient  *opsgenie.HeartbeatClient
+	teamClient       *opsgenie.TeamClient
+
+	// maxSnoozeDuration is the longest duration an alert can be snoozed for.
+	maxSnoozeDuration time.Duration
+
+	// enableDeleteAlert controls whether the destructive 'delete_alert' tool is registered.
+	enableDeleteAlert bool
 }
 
 // RegisterOpsGenieHandler registers the OpsGenie MCP tools with the provided MCP server.
-// It creates an alert client using the specified API URL and environment variable for authentication,
-// then registers the available tools (currently 'list_alerts') with the server.
+// It creates the alert, escalation, heartbeat and team clients using the specified API URL and
+// environment variable for authentication, then registers the available tools with the server:
+//   - alert tools to search, read, create and update alerts, including their notes, logs,
+//     recipients and attachments, and 'delete_alert' if enabled
+//   - alert query tools to validate, build and summarize alert searches, and to manage saved searches
+//   - bulk tools to acknowledge or close all alerts matching a query
+//   - a tool to look up the status of asynchronous alert requests
+//   - escalation, heartbeat and team tools
 //
 // Parameters:
 //   - s: The MCP server instance to register tools with
 //   - apiUrl: The OpsGenie API URL endpoint
 //   - envVar: The name of the environment variable containing the OpsGenie API key
+//   - maxSnoozeDuration: The longest duration an alert can be snoozed for
+//   - enableDeleteAlert: Whether to register the destructive 'delete_alert' tool
 //
-// Returns an error if the alert client cannot be created or if tool registration fails.
-func RegisterOpsGenieHandler(s *server.MCPServer, apiUrl, envVar string) error {
+// Returns an error if one of the clients cannot be created.
+func RegisterOpsGenieHandler(s *server.MCPServer, apiUrl, envVar string, maxSnoozeDuration time.Duration, enableDeleteAlert bool) error {
 	alertClient, err := opsgenie.NewAlertClient(apiUrl, envVar)
 	if err != nil {
 		return fmt.Errorf("failed to create OpsGenie alert client: %w", err)
 	}
 
+	escalationClient, err := opsgenie.NewEscalationClient(apiUrl, envVar)
+	if err != nil {
+		return fmt.Errorf("failed to create OpsGenie escalation client: %w", err)
+	}
+
 	heartbeatClient, err := opsgenie.NewHeartbeatClient(apiUrl, envVar)
 	if err != nil {
 		return fmt.Errorf("failed to create OpsGenie heartbeat client: %w", err)
@@ -46,12 +67,23 @@ func RegisterOpsGenieHandler(s *server.MCPServer, apiUrl, envVar string) error {
 
 	// Initialize the handler with the alert client
 	handler := &opsgenieHandler{
-		alertClient:     alertClient,
-		heartbeatClient: heartbeatClient,
-		teamClient:      teamClient,
+		alertClient:      alertClient,
+		escalationClient: escalationClient,
+		heartbeatClient:  heartbeatClient,
+		teamClient:       teamClient,
+
+		maxSnoozeDuration: maxSnoozeDuration,
+		enableDeleteAlert: enableDeleteAlert,
 	}
 
 	handler.registerAlertTools(s)
+	handler.registerAlertAttachmentTools(s)
+	handler.registerAlertBulkTools(s)
+	handler.registerAlertQueryTools(s)
+	handler.registerAlertRequestTools(s)
+	handler.registerAlertSavedSearchTools(s)
+	handler.registerAlertSummaryTools(s)
+	handler.registerEscalationTools(s)
 	handler.registerHeartbeatTools(s)
 	handler.registerTeamTools(s)
 
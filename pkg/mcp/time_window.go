@@ -0,0 +1,147 @@
+package mcp
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	// Embed the time zone database so that the 'timezone' argument works in minimal containers
+	_ "time/tzdata"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// timeWindowDescription documents the values accepted by the 'since' and 'until' arguments.
+const timeWindowDescription = `Accepted values are:
+- a duration before now, e.g. '30m', '6h', '7d' or '2w'
+- an RFC3339 timestamp, e.g. '2024-05-01T08:00:00Z'
+- a date, e.g. '2024-05-01', meaning the start of that day in 'timezone'
+- 'today' or 'yesterday', meaning the start of that day in 'timezone'`
+
+// dayDurationPattern matches durations in days or weeks, which time.ParseDuration does not support.
+var dayDurationPattern = regexp.MustCompile(`^(\d+)([dw])$`)
+
+// timeWindow restricts an alert search to alerts created within a time range.
+// A zero time leaves the corresponding side of the range open.
+type timeWindow struct {
+	since time.Time
+	until time.Time
+}
+
+// withTimeWindow adds the 'since', 'until' and 'timezone' arguments to an alert search tool.
+func withTimeWindow() mcp.ToolOption {
+	return func(t *mcp.Tool) {
+		mcp.WithString("since",
+			mcp.Description("Optional start of the time window, only alerts created at or after it are included. "+timeWindowDescription),
+		)(t)
+		mcp.WithString("until",
+			mcp.Description("Optional end of the time window, only alerts created before it are included. "+timeWindowDescription),
+		)(t)
+		mcp.WithString("timezone",
+			mcp.Description("Optional IANA time zone, e.g. 'Europe/Berlin', used for dates, 'today', 'yesterday' and durations in days or weeks in 'since' and 'until'. Defaults to UTC."),
+		)(t)
+	}
+}
+
+// getTimeWindow extracts the 'since', 'until' and 'timezone' arguments of an alert search tool.
+func getTimeWindow(request mcp.CallToolRequest, now time.Time) (timeWindow, error) {
+	sinceArg := request.GetString("since", "")
+	untilArg := request.GetString("until", "")
+
+	location := time.UTC
+	if name := request.GetString("timezone", ""); name != "" {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return timeWindow{}, fmt.Errorf("invalid timezone '%s': %w", name, err)
+		}
+		location = loc
+	}
+
+	var (
+		window timeWindow
+		err    error
+	)
+	if sinceArg != "" {
+		if window.since, err = parseTimeWindowBound(sinceArg, now, location); err != nil {
+			return timeWindow{}, fmt.Errorf("invalid 'since' value: %w", err)
+		}
+	}
+	if untilArg != "" {
+		if window.until, err = parseTimeWindowBound(untilArg, now, location); err != nil {
+			return timeWindow{}, fmt.Errorf("invalid 'until' value: %w", err)
+		}
+	}
+
+	if !window.since.IsZero() && !window.until.IsZero() && !window.since.Before(window.until) {
+		return timeWindow{}, fmt.Errorf("'since' (%s) must be before 'until' (%s)", window.since.Format(time.RFC3339), window.until.Format(time.RFC3339))
+	}
+
+	return window, nil
+}
+
+// isZero reports whether the time window is open on both sides.
+func (w timeWindow) isZero() bool {
+	return w.since.IsZero() && w.until.IsZero()
+}
+
+// apply combines a query with createdAt clauses restricting it to the time window.
+func (w timeWindow) apply(query string) string {
+	var clauses []string
+	if !w.since.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("createdAt >= %d", w.since.UnixMilli()))
+	}
+	if !w.until.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("createdAt < %d", w.until.UnixMilli()))
+	}
+
+	if len(clauses) == 0 {
+		return query
+	}
+
+	return andQuery(query, strings.Join(clauses, " AND "))
+}
+
+// parseTimeWindowBound parses a 'since' or 'until' value relative to now.
+// Dates, 'today' and 'yesterday' refer to the start of the day in the given location,
+// and durations in days or weeks count calendar days in that location.
+func parseTimeWindowBound(value string, now time.Time, location *time.Location) (time.Time, error) {
+	startOfToday := func() time.Time {
+		local := now.In(location)
+		return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+	}
+
+	switch value {
+	case "today":
+		return startOfToday(), nil
+	case "yesterday":
+		return startOfToday().AddDate(0, 0, -1), nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.ParseInLocation(time.DateOnly, value, location); err == nil {
+		return t, nil
+	}
+
+	if match := dayDurationPattern.FindStringSubmatch(value); match != nil {
+		n, err := strconv.Atoi(match[1])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid duration '%s': %w", value, err)
+		}
+		if match[2] == "w" {
+			n *= 7
+		}
+		// Calendar days keep the time of day across DST changes in the given location
+		return now.In(location).AddDate(0, 0, -n), nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("'%s' is neither a duration such as 6h or 7d, an RFC3339 timestamp, a date such as 2024-05-01, 'today' nor 'yesterday'", value)
+	}
+
+	return now.Add(-d), nil
+}
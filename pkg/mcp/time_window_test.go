@@ -0,0 +1,356 @@
+package mcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// mustLoadLocation loads a time zone or fails the test.
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %s: %v", name, err)
+	}
+
+	return location
+}
+
+// mustParseTime parses an RFC3339 timestamp or fails the test.
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("failed to parse time %s: %v", value, err)
+	}
+
+	return parsed
+}
+
+func TestParseTimeWindowBound(t *testing.T) {
+	now := mustParseTime(t, "2024-05-10T15:04:05Z")
+
+	testCases := []struct {
+		name        string
+		value       string
+		now         time.Time
+		location    string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "today in UTC",
+			value:    "today",
+			location: "UTC",
+			expected: "2024-05-10T00:00:00Z",
+		},
+		{
+			name:     "yesterday in UTC",
+			value:    "yesterday",
+			location: "UTC",
+			expected: "2024-05-09T00:00:00Z",
+		},
+		{
+			name:     "today in a time zone ahead of UTC on the next day",
+			value:    "today",
+			now:      mustParseTime(t, "2024-05-10T20:00:00Z"),
+			location: "Asia/Tokyo",
+			expected: "2024-05-10T15:00:00Z",
+		},
+		{
+			name:     "yesterday across a DST change",
+			value:    "yesterday",
+			now:      mustParseTime(t, "2024-03-31T12:00:00Z"),
+			location: "Europe/Berlin",
+			expected: "2024-03-29T23:00:00Z",
+		},
+		{
+			name:     "RFC3339 timestamp ignores the location",
+			value:    "2024-05-01T08:00:00+02:00",
+			location: "America/New_York",
+			expected: "2024-05-01T06:00:00Z",
+		},
+		{
+			name:     "date in UTC",
+			value:    "2024-05-01",
+			location: "UTC",
+			expected: "2024-05-01T00:00:00Z",
+		},
+		{
+			name:     "date in a time zone",
+			value:    "2024-05-01",
+			location: "Europe/Berlin",
+			expected: "2024-04-30T22:00:00Z",
+		},
+		{
+			name:     "minutes",
+			value:    "30m",
+			location: "UTC",
+			expected: "2024-05-10T14:34:05Z",
+		},
+		{
+			name:     "hours",
+			value:    "6h",
+			location: "UTC",
+			expected: "2024-05-10T09:04:05Z",
+		},
+		{
+			name:     "fractional hours",
+			value:    "1.5h",
+			location: "UTC",
+			expected: "2024-05-10T13:34:05Z",
+		},
+		{
+			name:     "days",
+			value:    "7d",
+			location: "UTC",
+			expected: "2024-05-03T15:04:05Z",
+		},
+		{
+			name:     "weeks",
+			value:    "2w",
+			location: "UTC",
+			expected: "2024-04-26T15:04:05Z",
+		},
+		{
+			name:     "zero days",
+			value:    "0d",
+			location: "UTC",
+			expected: "2024-05-10T15:04:05Z",
+		},
+		{
+			name:     "days keep the local time of day across the end of DST",
+			value:    "7d",
+			now:      mustParseTime(t, "2024-10-30T11:00:00Z"),
+			location: "Europe/Berlin",
+			expected: "2024-10-23T10:00:00Z",
+		},
+		{
+			name:     "weeks keep the local time of day across the start of DST",
+			value:    "1w",
+			now:      mustParseTime(t, "2024-04-02T10:00:00Z"),
+			location: "Europe/Berlin",
+			expected: "2024-03-26T11:00:00Z",
+		},
+		{
+			name:     "hours ignore DST",
+			value:    "168h",
+			now:      mustParseTime(t, "2024-10-30T11:00:00Z"),
+			location: "Europe/Berlin",
+			expected: "2024-10-23T11:00:00Z",
+		},
+		{
+			name:        "negative duration",
+			value:       "-5m",
+			location:    "UTC",
+			expectError: true,
+		},
+		{
+			name:        "unknown unit",
+			value:       "5x",
+			location:    "UTC",
+			expectError: true,
+		},
+		{
+			name:        "days without a number",
+			value:       "d",
+			location:    "UTC",
+			expectError: true,
+		},
+		{
+			name:        "invalid date",
+			value:       "2024-13-01",
+			location:    "UTC",
+			expectError: true,
+		},
+		{
+			name:        "free text",
+			value:       "last week",
+			location:    "UTC",
+			expectError: true,
+		},
+		{
+			name:        "empty value",
+			value:       "",
+			location:    "UTC",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			current := now
+			if !tc.now.IsZero() {
+				current = tc.now
+			}
+
+			bound, err := parseTimeWindowBound(tc.value, current, mustLoadLocation(t, tc.location))
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", bound.Format(time.RFC3339))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected := mustParseTime(t, tc.expected)
+			if !bound.Equal(expected) {
+				t.Errorf("expected %s, got %s", expected.Format(time.RFC3339), bound.UTC().Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestGetTimeWindow(t *testing.T) {
+	now := mustParseTime(t, "2024-05-10T15:04:05Z")
+
+	testCases := []struct {
+		name          string
+		arguments     map[string]any
+		expectedSince string
+		expectedUntil string
+		expectError   bool
+	}{
+		{
+			name:      "no arguments",
+			arguments: map[string]any{},
+		},
+		{
+			name:          "since only",
+			arguments:     map[string]any{"since": "6h"},
+			expectedSince: "2024-05-10T09:04:05Z",
+		},
+		{
+			name:          "until only",
+			arguments:     map[string]any{"until": "2024-05-01"},
+			expectedUntil: "2024-05-01T00:00:00Z",
+		},
+		{
+			name:          "since and until in a time zone",
+			arguments:     map[string]any{"since": "yesterday", "until": "today", "timezone": "Europe/Berlin"},
+			expectedSince: "2024-05-08T22:00:00Z",
+			expectedUntil: "2024-05-09T22:00:00Z",
+		},
+		{
+			name:        "since after until",
+			arguments:   map[string]any{"since": "1d", "until": "2d"},
+			expectError: true,
+		},
+		{
+			name:        "since equal to until",
+			arguments:   map[string]any{"since": "2024-05-01", "until": "2024-05-01"},
+			expectError: true,
+		},
+		{
+			name:        "invalid since",
+			arguments:   map[string]any{"since": "soon"},
+			expectError: true,
+		},
+		{
+			name:        "invalid until",
+			arguments:   map[string]any{"until": "later"},
+			expectError: true,
+		},
+		{
+			name:        "invalid timezone",
+			arguments:   map[string]any{"since": "today", "timezone": "Mars/Olympus_Mons"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var request mcp.CallToolRequest
+			request.Params.Arguments = tc.arguments
+
+			window, err := getTimeWindow(request, now)
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got window %+v", window)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			checkBound := func(name string, actual time.Time, expected string) {
+				t.Helper()
+				if expected == "" {
+					if !actual.IsZero() {
+						t.Errorf("expected no %s, got %s", name, actual.Format(time.RFC3339))
+					}
+					return
+				}
+				if !actual.Equal(mustParseTime(t, expected)) {
+					t.Errorf("expected %s %s, got %s", name, expected, actual.UTC().Format(time.RFC3339))
+				}
+			}
+			checkBound("since", window.since, tc.expectedSince)
+			checkBound("until", window.until, tc.expectedUntil)
+
+			if window.isZero() != (tc.expectedSince == "" && tc.expectedUntil == "") {
+				t.Errorf("unexpected isZero %t for window %+v", window.isZero(), window)
+			}
+		})
+	}
+}
+
+func TestTimeWindowApply(t *testing.T) {
+	since := mustParseTime(t, "2024-05-01T00:00:00Z")
+	until := mustParseTime(t, "2024-05-02T00:00:00Z")
+
+	testCases := []struct {
+		name     string
+		window   timeWindow
+		query    string
+		expected string
+	}{
+		{
+			name:     "zero window leaves query unchanged",
+			query:    "status:open",
+			expected: "status:open",
+		},
+		{
+			name:     "zero window leaves empty query unchanged",
+			expected: "",
+		},
+		{
+			name:     "since only",
+			window:   timeWindow{since: since},
+			query:    "status:open",
+			expected: "(status:open) AND createdAt >= 1714521600000",
+		},
+		{
+			name:     "until only",
+			window:   timeWindow{until: until},
+			query:    "status:open OR priority:P1",
+			expected: "(status:open OR priority:P1) AND createdAt < 1714608000000",
+		},
+		{
+			name:     "since and until",
+			window:   timeWindow{since: since, until: until},
+			query:    "status:open",
+			expected: "(status:open) AND createdAt >= 1714521600000 AND createdAt < 1714608000000",
+		},
+		{
+			name:     "since and until without query",
+			window:   timeWindow{since: since, until: until},
+			expected: "createdAt >= 1714521600000 AND createdAt < 1714608000000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.window.apply(tc.query); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -4,10 +4,15 @@ package opsgenie
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
@@ -24,12 +29,58 @@ const (
 	// This limit is enforced by the OpsGenie API.
 	// Reference: https://docs.opsgenie.com/docs/alert-api#list-alerts
 	maxTotalAlerts = 20000
+
+	// maxNotesPerRequest is the maximum number of alert notes that can be fetched in a single API request.
+	// This limit is enforced by the OpsGenie API.
+	// Reference: https://docs.opsgenie.com/docs/alert-api-continued#list-alert-notes
+	maxNotesPerRequest = 100
+
+	// maxLogsPerRequest is the maximum number of alert log entries that can be fetched in a single API request.
+	// This limit is enforced by the OpsGenie API.
+	// Reference: https://docs.opsgenie.com/docs/alert-api-continued#list-alert-logs
+	maxLogsPerRequest = 100
+
+	// maxTotalLogs is the maximum total number of alert log entries fetched across all paginated requests.
+	// It protects against unbounded pagination for alerts with a very long history.
+	maxTotalLogs = 10000
+
+	// attachmentDownloadTimeout is the maximum duration of an attachment download,
+	// so that a stalled download does not block a tool call indefinitely.
+	attachmentDownloadTimeout = 60 * time.Second
 )
 
+// ErrRequestNotProcessed is returned by GetRequestStatus when OpsGenie has not processed the request yet.
+var ErrRequestNotProcessed = errors.New("request has not been processed yet")
+
+// ErrAttachmentTooLarge is returned by DownloadAttachment when the attachment exceeds the requested size limit.
+var ErrAttachmentTooLarge = errors.New("attachment exceeds the maximum size")
+
 // AlertClient is a wrapper around the OpsGenie alert client that provides
 // enhanced functionality for fetching and managing alerts.
 type AlertClient struct {
 	*alert.Client
+
+	// opsgenieClient executes requests whose SDK result types cannot parse the API response.
+	opsgenieClient *client.OpsGenieClient
+
+	// downloadClient downloads attachment content from the signed URLs returned by OpsGenie.
+	downloadClient *http.Client
+
+	// noWait makes write methods return as soon as OpsGenie has accepted a request.
+	noWait bool
+}
+
+// SavedSearch is a saved alert search as returned when listing saved searches.
+type SavedSearch struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// listSavedSearchesResult is the result of listing saved searches. The SDK result type
+// only holds a single saved search and fails to parse the list returned by the API.
+type listSavedSearchesResult struct {
+	client.ResultMetadata
+	SavedSearches []SavedSearch `json:"data"`
 }
 
 // NewAlertClient creates a new AlertClient instance configured with the provided API URL and API key.
@@ -57,13 +108,71 @@ func NewAlertClient(apiUrl, envVar string) (*AlertClient, error) {
 		return nil, fmt.Errorf("failed to create OpsGenie alert client: %w", err)
 	}
 
+	opsgenieClient, err := client.NewOpsGenieClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OpsGenie client: %w", err)
+	}
+
 	a := &AlertClient{
-		Client: alertClient,
+		Client:         alertClient,
+		opsgenieClient: opsgenieClient,
+		downloadClient: &http.Client{Timeout: attachmentDownloadTimeout},
 	}
 
 	return a, nil
 }
 
+// WithoutWaiting returns a copy of the client whose write methods return as soon as OpsGenie
+// has accepted a request, instead of waiting until the request has been processed.
+// The results of these methods only contain the request ID, which can be passed to
+// GetRequestStatus to look up the outcome of the request later.
+//
+// Returns:
+//   - *AlertClient: A client that does not wait for requests to be processed
+func (a *AlertClient) WithoutWaiting() *AlertClient {
+	c := *a
+	c.noWait = true
+
+	return &c
+}
+
+// acceptedRequest returns the status of a request that has been accepted but not necessarily processed yet.
+func acceptedRequest(response *alert.AsyncAlertResult) *alert.RequestStatusResult {
+	slog.Info("accepted request without waiting for it to be processed", "requestId", response.RequestId)
+
+	return &alert.RequestStatusResult{ResultMetadata: response.ResultMetadata}
+}
+
+// GetRequestStatus retrieves the outcome of an asynchronous OpsGenie alert request.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - requestID: The ID of the request, as returned by the write methods
+//
+// Returns:
+//   - *alert.RequestStatusResult: The status of the request, including whether it succeeded
+//   - error: ErrRequestNotProcessed if OpsGenie has not processed the request yet, or an error if the API request fails
+func (a *AlertClient) GetRequestStatus(ctx context.Context, requestID string) (*alert.RequestStatusResult, error) {
+	slog.Info("fetching request status", "requestId", requestID)
+
+	statusRequest := &alert.GetRequestStatusRequest{
+		RequestId: requestID,
+	}
+
+	response, err := a.Client.GetRequestStatus(ctx, statusRequest)
+	if err != nil {
+		var apiErr *client.ApiError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound && apiErr.ErrorHeader == "RequestNotProcessed" {
+			return nil, ErrRequestNotProcessed
+		}
+		return nil, fmt.Errorf("failed to get status of request %s: %w", requestID, err)
+	}
+
+	slog.Info("fetched request status", "requestId", requestID, "isSuccess", response.IsSuccess, "status", response.Status)
+
+	return response, nil
+}
+
 // ListAlerts retrieves alerts from OpsGenie based on the provided query string.
 // The method handles pagination automatically, fetching all matching alerts up to the maximum limit.
 //
@@ -75,31 +184,58 @@ func NewAlertClient(apiUrl, envVar string) (*AlertClient, error) {
 // Parameters:
 //   - ctx: Context for request cancellation and timeout control
 //   - query: OpsGenie query string for filtering alerts (empty string fetches all alerts)
+//   - sort: The field to sort the alerts by (e.g. alert.CreatedAt)
+//   - order: The sorting order of the alerts (alert.Asc or alert.Desc)
 //
 // Returns:
 //   - []alert.Alert: A slice of alerts matching the query criteria
 //   - error: An error if the API request fails or if the context is cancelled
-func (a *AlertClient) ListAlerts(ctx context.Context, query string) ([]alert.Alert, error) {
+func (a *AlertClient) ListAlerts(ctx context.Context, query string, sort alert.SortField, order alert.Order) ([]alert.Alert, error) {
+	return a.ListAlertsUpTo(ctx, query, sort, order, maxTotalAlerts)
+}
+
+// ListAlertsUpTo retrieves at most max alerts from OpsGenie based on the provided query string.
+// Like ListAlerts it handles pagination automatically, but it stops requesting pages as soon as
+// max alerts have been fetched, so callers that only need to know whether a query matches more
+// than a given number of alerts do not page through all of them.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - query: OpsGenie query string for filtering alerts (empty string fetches all alerts)
+//   - sort: The field to sort the alerts by (e.g. alert.CreatedAt)
+//   - order: The sorting order of the alerts (alert.Asc or alert.Desc)
+//   - max: The maximum number of alerts to fetch, capped at 20000
+//
+// Returns:
+//   - []alert.Alert: A slice of at most max alerts matching the query criteria
+//   - error: An error if the API request fails or if the context is cancelled
+func (a *AlertClient) ListAlertsUpTo(ctx context.Context, query string, sort alert.SortField, order alert.Order, max int) ([]alert.Alert, error) {
 	if ctx == nil {
 		return nil, fmt.Errorf("context cannot be nil")
 	}
+	if max < 1 {
+		return nil, fmt.Errorf("max must be positive, got %d", max)
+	}
+	max = min(max, maxTotalAlerts)
 
-	alerts := make([]alert.Alert, 0, maxAlertsPerRequest)
-	offset := 0
+	alerts := make([]alert.Alert, 0, min(max, maxAlertsPerRequest))
 
 	slog.Info("fetching alerts",
 		"query", query,
+		"sort", sort,
+		"order", order,
 		"max_per_request", maxAlertsPerRequest,
-		"max_total", maxTotalAlerts)
+		"max_total", max)
 
-	// Paginate through all available alerts until we reach the limit or no more alerts exist
-	for offset < maxTotalAlerts {
+	// Paginate through the matching alerts until we reach the limit or no more alerts exist
+	for len(alerts) < max {
 		// Prepare the list request with pagination parameters
+		limit := min(maxAlertsPerRequest, max-len(alerts))
 		listRequest := &alert.ListAlertRequest{
-			Offset: offset,
-			Limit:  maxAlertsPerRequest,
-			Sort:   alert.CreatedAt, // Sort by creation time
-			Order:  alert.Desc,      // Most recent alerts first
+			Offset: len(alerts),
+			Limit:  limit,
+			Sort:   sort,
+			Order:  order,
 			Query:  query,
 		}
 
@@ -108,14 +244,13 @@ func (a *AlertClient) ListAlerts(ctx context.Context, query string) ([]alert.Ale
 			return nil, fmt.Errorf("failed to list alerts: %w", err)
 		}
 
-		// If no alerts are returned, we've reached the end of available data
-		if len(response.Alerts) == 0 {
-			break
-		}
-
 		// Append the fetched alerts to our result set
 		alerts = append(alerts, response.Alerts...)
-		offset += maxAlertsPerRequest
+
+		// A partial page means we've reached the end of available data
+		if len(response.Alerts) < limit {
+			break
+		}
 	}
 
 	slog.Info("fetched alerts", "count", len(alerts))
@@ -123,21 +258,96 @@ func (a *AlertClient) ListAlerts(ctx context.Context, query string) ([]alert.Ale
 	return alerts, nil
 }
 
-// GetAlert retrieves a single alert from OpsGenie by its ID.
+// ListAlertsPage retrieves a single page of alerts from OpsGenie based on the provided query string.
+// Unlike ListAlerts, it does not paginate through all matching alerts.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - query: OpsGenie query string for filtering alerts (empty string fetches all alerts)
+//   - sort: The field to sort the alerts by (e.g. alert.CreatedAt)
+//   - order: The sorting order of the alerts (alert.Asc or alert.Desc)
+//   - offset: The number of matching alerts to skip
+//   - limit: The maximum number of alerts to return, at most 100
+//
+// Returns:
+//   - []alert.Alert: A slice of at most limit alerts matching the query criteria
+//   - error: An error if the API request fails or if the context is cancelled
+func (a *AlertClient) ListAlertsPage(ctx context.Context, query string, sort alert.SortField, order alert.Order, offset, limit int) ([]alert.Alert, error) {
+	if limit < 1 || limit > maxAlertsPerRequest {
+		return nil, fmt.Errorf("limit must be between 1 and %d", maxAlertsPerRequest)
+	}
+	if offset < 0 || offset+limit > maxTotalAlerts {
+		return nil, fmt.Errorf("offset must be between 0 and %d", maxTotalAlerts-limit)
+	}
+
+	slog.Info("fetching alerts page",
+		"query", query,
+		"sort", sort,
+		"order", order,
+		"offset", offset,
+		"limit", limit)
+
+	listRequest := &alert.ListAlertRequest{
+		Offset: offset,
+		Limit:  limit,
+		Sort:   sort,
+		Order:  order,
+		Query:  query,
+	}
+
+	response, err := a.Client.List(ctx, listRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alerts: %w", err)
+	}
+
+	slog.Info("fetched alerts page", "count", len(response.Alerts))
+
+	return response.Alerts, nil
+}
+
+// CountAlerts returns the number of alerts in OpsGenie matching the provided query string,
+// without fetching the alerts themselves.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - query: OpsGenie query string for filtering alerts (empty string counts all alerts)
+//
+// Returns:
+//   - int: The number of alerts matching the query criteria
+//   - error: An error if the API request fails or if the context is cancelled
+func (a *AlertClient) CountAlerts(ctx context.Context, query string) (int, error) {
+	slog.Info("counting alerts", "query", query)
+
+	countRequest := &alert.CountAlertsRequest{
+		Query: query,
+	}
+
+	response, err := a.Client.CountAlerts(ctx, countRequest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count alerts: %w", err)
+	}
+
+	slog.Info("counted alerts", "query", query, "count", response.Count)
+
+	return response.Count, nil
+}
+
+// GetAlert retrieves a single alert from OpsGenie by its ID, alias or tiny ID.
 //
 // Parameters:
 //   - ctx: Context for request cancellation and timeout control
-//   - id: The unique identifier of the alert to retrieve
+//   - id: The identifier of the alert to retrieve
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
 //
 // Returns:
 //   - *alert.GetAlertResult: The alert details
 //   - error: An error if the API request fails
-func (a *AlertClient) GetAlert(ctx context.Context, id string) (*alert.GetAlertResult, error) {
+func (a *AlertClient) GetAlert(ctx context.Context, id string, idType alert.AlertIdentifier) (*alert.GetAlertResult, error) {
 	slog.Info("fetching alert", "id", id)
 
 	getRequest := &alert.GetAlertRequest{
 		IdentifierValue: id,
-		IdentifierType:  alert.ALERTID,
+		IdentifierType:  idType,
 	}
 
 	response, err := a.Client.Get(ctx, getRequest)
@@ -155,6 +365,7 @@ func (a *AlertClient) GetAlert(ctx context.Context, id string) (*alert.GetAlertR
 // Parameters:
 //   - ctx: Context for request cancellation and timeout control
 //   - id: The identifier of the alert to acknowledge
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
 //   - user: Display name of the request owner
 //   - note: Additional note to add to the alert
 //   - source: Display name of the request source
@@ -162,12 +373,12 @@ func (a *AlertClient) GetAlert(ctx context.Context, id string) (*alert.GetAlertR
 // Returns:
 //   - *alert.AcknowledgeResult: The result of the acknowledgement operation
 //   - error: An error if the API request fails or the context is cancelled
-func (a *AlertClient) AcknowledgeAlert(ctx context.Context, id, user, note, source string) (*alert.RequestStatusResult, error) {
+func (a *AlertClient) AcknowledgeAlert(ctx context.Context, id string, idType alert.AlertIdentifier, user, note, source string) (*alert.RequestStatusResult, error) {
 	slog.Info("acknowledging alert", "id", id, "user", user, "source", source)
 
 	ackRequest := &alert.AcknowledgeAlertRequest{
 		IdentifierValue: id,
-		IdentifierType:  alert.ALERTID,
+		IdentifierType:  idType,
 		User:            user,
 		Note:            note,
 		Source:          source,
@@ -178,6 +389,10 @@ func (a *AlertClient) AcknowledgeAlert(ctx context.Context, id, user, note, sour
 		return nil, fmt.Errorf("failed to acknowledge alert with ID %s: %w", id, err)
 	}
 
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
 	result, err := response.RetrieveStatus(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve status of acknowledgement request: %w", err)
@@ -197,6 +412,7 @@ func (a *AlertClient) AcknowledgeAlert(ctx context.Context, id, user, note, sour
 // Parameters:
 //   - ctx: Context for request cancellation and timeout control
 //   - id: The identifier of the alert to unacknowledge
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
 //   - user: Display name of the request owner
 //   - note: Additional note to add to the alert
 //   - source: Display name of the request source
@@ -204,12 +420,12 @@ func (a *AlertClient) AcknowledgeAlert(ctx context.Context, id, user, note, sour
 // Returns:
 //   - *alert.RequestStatusResult: The result of the unacknowledgement operation
 //   - error: An error if the API request fails or the context is cancelled
-func (a *AlertClient) UnacknowledgeAlert(ctx context.Context, id, user, note, source string) (*alert.RequestStatusResult, error) {
+func (a *AlertClient) UnacknowledgeAlert(ctx context.Context, id string, idType alert.AlertIdentifier, user, note, source string) (*alert.RequestStatusResult, error) {
 	slog.Info("unacknowledging alert", "id", id, "user", user, "source", source)
 
 	unackRequest := &alert.UnacknowledgeAlertRequest{
 		IdentifierValue: id,
-		IdentifierType:  alert.ALERTID,
+		IdentifierType:  idType,
 		User:            user,
 		Note:            note,
 		Source:          source,
@@ -220,6 +436,10 @@ func (a *AlertClient) UnacknowledgeAlert(ctx context.Context, id, user, note, so
 		return nil, fmt.Errorf("failed to unacknowledge alert with ID %s: %w", id, err)
 	}
 
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
 	result, err := response.RetrieveStatus(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve status of unacknowledgement request: %w", err)
@@ -233,3 +453,1202 @@ func (a *AlertClient) UnacknowledgeAlert(ctx context.Context, id, user, note, so
 
 	return result, nil
 }
+
+// CloseAlert closes an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to close
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the close operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) CloseAlert(ctx context.Context, id string, idType alert.AlertIdentifier, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("closing alert", "id", id, "user", user, "source", source)
+
+	closeRequest := &alert.CloseAlertRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.Close(ctx, closeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of close request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to close alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("closed alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// CreateAlert creates a new alert in OpsGenie and waits for the asynchronous
+// request to be processed. When the request carries an alias that matches an
+// open alert, OpsGenie deduplicates it and increases the count of the existing
+// alert instead of creating a new one.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - createRequest: The alert to create, including responders, tags and details
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the create operation, including the alert ID
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) CreateAlert(ctx context.Context, createRequest *alert.CreateAlertRequest) (*alert.RequestStatusResult, error) {
+	if createRequest == nil {
+		return nil, fmt.Errorf("create request cannot be nil")
+	}
+
+	slog.Info("creating alert",
+		"alias", createRequest.Alias,
+		"priority", createRequest.Priority,
+		"user", createRequest.User,
+		"source", createRequest.Source)
+
+	response, err := a.Client.Create(ctx, createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create alert: %w", err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of create request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to create alert: %s", result.Status)
+	}
+
+	slog.Info("created alert", "id", result.AlertID, "alias", result.Alias, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// SnoozeAlert snoozes an alert in OpsGenie until the given end time.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to snooze
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - endTime: The time at which the alert is unsnoozed
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the snooze operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) SnoozeAlert(ctx context.Context, id string, idType alert.AlertIdentifier, endTime time.Time, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("snoozing alert", "id", id, "endTime", endTime, "user", user, "source", source)
+
+	snoozeRequest := &alert.SnoozeAlertRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		EndTime:         endTime,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.Snooze(ctx, snoozeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snooze alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of snooze request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to snooze alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("snoozed alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// AddNote adds a note to an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to add the note to
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - user: Display name of the request owner
+//   - note: The note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the add note operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) AddNote(ctx context.Context, id string, idType alert.AlertIdentifier, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("adding note to alert", "id", id, "user", user, "source", source)
+
+	addNoteRequest := &alert.AddNoteRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.AddNote(ctx, addNoteRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add note to alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of add note request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to add note to alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("added note to alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// ListAlertNotes retrieves a page of notes of an alert in OpsGenie.
+// The paging information of the result can be used to fetch the next or previous page.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to list the notes of
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - offset: The offset to start the page from (empty string starts from the beginning)
+//   - direction: The page direction relative to the offset (alert.NEXT or alert.PREV)
+//   - order: The sorting order of the notes by creation time (alert.Asc or alert.Desc)
+//   - limit: The maximum number of notes to return (at most 100)
+//
+// Returns:
+//   - *alert.ListAlertNotesResult: The notes and paging information
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) ListAlertNotes(ctx context.Context, id string, idType alert.AlertIdentifier, offset string, direction alert.RequestDirection, order alert.Order, limit int) (*alert.ListAlertNotesResult, error) {
+	if limit <= 0 || limit > maxNotesPerRequest {
+		limit = maxNotesPerRequest
+	}
+
+	slog.Info("fetching alert notes", "id", id, "offset", offset, "direction", direction, "order", order, "limit", limit)
+
+	listRequest := &alert.ListAlertNotesRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Offset:          offset,
+		Direction:       direction,
+		Order:           order,
+		Limit:           uint32(limit),
+	}
+
+	response, err := a.Client.ListAlertNotes(ctx, listRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notes of alert with ID %s: %w", id, err)
+	}
+
+	slog.Info("fetched alert notes", "id", id, "count", len(response.AlertLog))
+
+	return response, nil
+}
+
+// AddTags adds tags to an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to add the tags to
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - tags: The tags to add to the alert
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the add tags operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) AddTags(ctx context.Context, id string, idType alert.AlertIdentifier, tags []string, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("adding tags to alert", "id", id, "tags", tags, "user", user, "source", source)
+
+	addTagsRequest := &alert.AddTagsRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Tags:            tags,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.AddTags(ctx, addTagsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add tags to alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of add tags request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to add tags to alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("added tags to alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// RemoveTags removes tags from an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to remove the tags from
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - tags: The tags to remove from the alert
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the remove tags operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) RemoveTags(ctx context.Context, id string, idType alert.AlertIdentifier, tags []string, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("removing tags from alert", "id", id, "tags", tags, "user", user, "source", source)
+
+	removeTagsRequest := &alert.RemoveTagsRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Tags:            strings.Join(tags, ","),
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.RemoveTags(ctx, removeTagsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove tags from alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of remove tags request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to remove tags from alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("removed tags from alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// AddDetails adds custom detail properties to an alert in OpsGenie.
+// Existing keys are overwritten with the new values.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to add the details to
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - details: The key-value pairs to add to the alert
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the add details operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) AddDetails(ctx context.Context, id string, idType alert.AlertIdentifier, details map[string]string, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("adding details to alert", "id", id, "keys", len(details), "user", user, "source", source)
+
+	addDetailsRequest := &alert.AddDetailsRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Details:         details,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.AddDetails(ctx, addDetailsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add details to alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of add details request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to add details to alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("added details to alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// RemoveDetails removes custom detail properties from an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to remove the details from
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - keys: The detail keys to remove from the alert
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the remove details operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) RemoveDetails(ctx context.Context, id string, idType alert.AlertIdentifier, keys []string, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("removing details from alert", "id", id, "keys", keys, "user", user, "source", source)
+
+	removeDetailsRequest := &alert.RemoveDetailsRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Keys:            strings.Join(keys, ","),
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.RemoveDetails(ctx, removeDetailsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove details from alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of remove details request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to remove details from alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("removed details from alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// AssignAlert assigns an owner to an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - owner: The user to assign as owner, identified by ID or username
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the assign operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) AssignAlert(ctx context.Context, id string, idType alert.AlertIdentifier, owner alert.User, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("assigning alert", "id", id, "ownerId", owner.ID, "ownerUsername", owner.Username, "user", user, "source", source)
+
+	assignRequest := &alert.AssignRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Owner:           owner,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.AssignAlert(ctx, assignRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of assign request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to assign alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("assigned alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// AddTeam adds a team to an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - team: The team to add, identified by ID or name
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the add team operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) AddTeam(ctx context.Context, id string, idType alert.AlertIdentifier, team alert.Team, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("adding team to alert", "id", id, "teamId", team.ID, "teamName", team.Name, "user", user, "source", source)
+
+	addTeamRequest := &alert.AddTeamRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Team:            team,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.AddTeam(ctx, addTeamRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add team to alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of add team request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to add team to alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("added team to alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// AddResponder adds a user or team responder to an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - responder: The user or team responder to add
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the add responder operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) AddResponder(ctx context.Context, id string, idType alert.AlertIdentifier, responder alert.Responder, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("adding responder to alert", "id", id, "responderType", responder.Type, "responderId", responder.Id, "user", user, "source", source)
+
+	addResponderRequest := &alert.AddResponderRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Responder:       responder,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.AddResponder(ctx, addResponderRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add responder to alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of add responder request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to add responder to alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("added responder to alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// EscalateAlert escalates an alert in OpsGenie to the next level of the given escalation policy.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to escalate
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - escalation: The escalation policy, identified by ID or name
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the escalate operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) EscalateAlert(ctx context.Context, id string, idType alert.AlertIdentifier, escalation alert.Escalation, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("escalating alert", "id", id, "escalationId", escalation.ID, "escalationName", escalation.Name, "user", user, "source", source)
+
+	escalateRequest := &alert.EscalateToNextRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Escalation:      escalation,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.EscalateToNext(ctx, escalateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to escalate alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of escalate request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to escalate alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("escalated alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// ExecuteCustomAction executes a custom action on an alert in OpsGenie.
+// Custom actions are defined by the integration that created the alert.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to execute the action on
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - action: The name of the custom action to execute
+//   - user: Display name of the request owner
+//   - note: Additional note to add to the alert
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the custom action operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) ExecuteCustomAction(ctx context.Context, id string, idType alert.AlertIdentifier, action, user, note, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("executing custom action on alert", "id", id, "action", action, "user", user, "source", source)
+
+	actionRequest := &alert.ExecuteCustomActionAlertRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Action:          action,
+		User:            user,
+		Note:            note,
+		Source:          source,
+	}
+
+	response, err := a.Client.ExecuteCustomAction(ctx, actionRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute action %s on alert with ID %s: %w", action, id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of custom action request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to execute action %s on alert with ID %s: %s", action, id, result.Status)
+	}
+
+	slog.Info("executed custom action on alert", "id", id, "action", action, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// UpdatePriority updates the priority of an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to update
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - priority: The new priority of the alert
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the update priority operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) UpdatePriority(ctx context.Context, id string, idType alert.AlertIdentifier, priority alert.Priority) (*alert.RequestStatusResult, error) {
+	slog.Info("updating alert priority", "id", id, "priority", priority)
+
+	updateRequest := &alert.UpdatePriorityRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Priority:        priority,
+	}
+
+	response, err := a.Client.UpdatePriority(ctx, updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update priority of alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of update priority request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to update priority of alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("updated alert priority", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// UpdateMessage updates the message of an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to update
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - message: The new message of the alert
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the update message operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) UpdateMessage(ctx context.Context, id string, idType alert.AlertIdentifier, message string) (*alert.RequestStatusResult, error) {
+	slog.Info("updating alert message", "id", id)
+
+	updateRequest := &alert.UpdateMessageRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Message:         message,
+	}
+
+	response, err := a.Client.UpdateMessage(ctx, updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update message of alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of update message request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to update message of alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("updated alert message", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// UpdateDescription updates the description of an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to update
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - description: The new description of the alert
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the update description operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) UpdateDescription(ctx context.Context, id string, idType alert.AlertIdentifier, description string) (*alert.RequestStatusResult, error) {
+	slog.Info("updating alert description", "id", id)
+
+	updateRequest := &alert.UpdateDescriptionRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Description:     description,
+	}
+
+	response, err := a.Client.UpdateDescription(ctx, updateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update description of alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of update description request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to update description of alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("updated alert description", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// DeleteAlert permanently deletes an alert from OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to delete
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - source: Display name of the request source
+//
+// Returns:
+//   - *alert.RequestStatusResult: The result of the delete operation
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) DeleteAlert(ctx context.Context, id string, idType alert.AlertIdentifier, source string) (*alert.RequestStatusResult, error) {
+	slog.Info("deleting alert", "id", id, "source", source)
+
+	deleteRequest := &alert.DeleteAlertRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		Source:          source,
+	}
+
+	response, err := a.Client.Delete(ctx, deleteRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete alert with ID %s: %w", id, err)
+	}
+
+	if a.noWait {
+		return acceptedRequest(response), nil
+	}
+
+	result, err := response.RetrieveStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve status of delete request: %w", err)
+	}
+
+	if !result.IsSuccess {
+		return nil, fmt.Errorf("failed to delete alert with ID %s: %s", id, result.Status)
+	}
+
+	slog.Info("deleted alert", "id", id, "requestId", result.RequestId)
+
+	return result, nil
+}
+
+// ListAlertLogs retrieves the activity log of an alert in OpsGenie.
+// The method handles pagination automatically, fetching all log entries up to the maximum limit.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to list the logs of
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - order: The sorting order of the log entries by creation time (alert.Asc or alert.Desc)
+//
+// Returns:
+//   - []alert.AlertLog: The log entries of the alert
+//   - bool: Whether the log has more entries than the maximum limit and was truncated
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) ListAlertLogs(ctx context.Context, id string, idType alert.AlertIdentifier, order alert.Order) ([]alert.AlertLog, bool, error) {
+	logs := make([]alert.AlertLog, 0, maxLogsPerRequest)
+	offset := ""
+	reachedEnd := false
+
+	slog.Info("fetching alert logs",
+		"id", id,
+		"order", order,
+		"max_per_request", maxLogsPerRequest,
+		"max_total", maxTotalLogs)
+
+	// Paginate through the log using the offset of the last entry of each page
+	for len(logs) < maxTotalLogs {
+		listRequest := &alert.ListAlertLogsRequest{
+			IdentifierValue: id,
+			IdentifierType:  idType,
+			Offset:          offset,
+			Direction:       alert.NEXT,
+			Order:           order,
+			Limit:           maxLogsPerRequest,
+		}
+
+		response, err := a.Client.ListAlertLogs(ctx, listRequest)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list logs of alert with ID %s: %w", id, err)
+		}
+
+		logs = append(logs, response.AlertLog...)
+
+		// A partial page or a missing offset means we've reached the end of the log
+		if len(response.AlertLog) < maxLogsPerRequest {
+			reachedEnd = true
+			break
+		}
+		offset = response.AlertLog[len(response.AlertLog)-1].Offset
+		if offset == "" {
+			reachedEnd = true
+			break
+		}
+	}
+
+	// The log may end exactly at the maximum limit, so only report truncation if an entry follows
+	truncated := false
+	if !reachedEnd {
+		probeRequest := &alert.ListAlertLogsRequest{
+			IdentifierValue: id,
+			IdentifierType:  idType,
+			Offset:          offset,
+			Direction:       alert.NEXT,
+			Order:           order,
+			Limit:           1,
+		}
+
+		response, err := a.Client.ListAlertLogs(ctx, probeRequest)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list logs of alert with ID %s: %w", id, err)
+		}
+		truncated = len(response.AlertLog) > 0
+	}
+
+	slog.Info("fetched alert logs", "id", id, "count", len(logs), "truncated", truncated)
+
+	return logs, truncated, nil
+}
+
+// ListAlertRecipients retrieves the recipients of an alert in OpsGenie together with
+// their notification state.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to list the recipients of
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//
+// Returns:
+//   - []alert.AlertRecipient: The recipients of the alert
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) ListAlertRecipients(ctx context.Context, id string, idType alert.AlertIdentifier) ([]alert.AlertRecipient, error) {
+	slog.Info("fetching alert recipients", "id", id)
+
+	listRequest := &alert.ListAlertRecipientRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+	}
+
+	response, err := a.Client.ListAlertRecipients(ctx, listRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recipients of alert with ID %s: %w", id, err)
+	}
+
+	slog.Info("fetched alert recipients", "id", id, "count", len(response.AlertRecipients))
+
+	return response.AlertRecipients, nil
+}
+
+// ListAttachments retrieves the attachments of an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to list the attachments of
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//
+// Returns:
+//   - []alert.ListedAttachment: The IDs and names of the attachments
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) ListAttachments(ctx context.Context, id string, idType alert.AlertIdentifier) ([]alert.ListedAttachment, error) {
+	slog.Info("fetching alert attachments", "id", id)
+
+	listRequest := &alert.ListAttachmentsRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+	}
+
+	response, err := a.Client.ListAlertsAttachments(ctx, listRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments of alert with ID %s: %w", id, err)
+	}
+
+	slog.Info("fetched alert attachments", "id", id, "count", len(response.Attachment))
+
+	return response.Attachment, nil
+}
+
+// GetAttachment retrieves the name and download URL of an alert attachment in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert the attachment belongs to
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - attachmentID: The identifier of the attachment
+//
+// Returns:
+//   - *alert.GetAttachmentResult: The name and download URL of the attachment
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) GetAttachment(ctx context.Context, id string, idType alert.AlertIdentifier, attachmentID string) (*alert.GetAttachmentResult, error) {
+	slog.Info("fetching alert attachment", "id", id, "attachmentId", attachmentID)
+
+	getRequest := &alert.GetAttachmentRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		AttachmentId:    attachmentID,
+	}
+
+	response, err := a.Client.GetAlertAttachment(ctx, getRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment %s of alert with ID %s: %w", attachmentID, id, err)
+	}
+
+	slog.Info("fetched alert attachment", "id", id, "attachmentId", attachmentID, "name", response.Name)
+
+	return response, nil
+}
+
+// DownloadAttachment downloads the content of an attachment from the URL returned by GetAttachment.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - url: The download URL of the attachment
+//   - maxBytes: The maximum size of the attachment to download
+//
+// Returns:
+//   - []byte: The content of the attachment
+//   - string: The content type reported by the server (may be empty)
+//   - error: ErrAttachmentTooLarge if the attachment exceeds maxBytes, or an error if the download fails
+func (a *AlertClient) DownloadAttachment(ctx context.Context, url string, maxBytes int64) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create attachment download request: %w", err)
+	}
+
+	resp, err := a.downloadClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download attachment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to download attachment: unexpected status %s", resp.Status)
+	}
+
+	// Read one byte past the limit to detect oversized attachments
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if int64(len(content)) > maxBytes {
+		return nil, "", ErrAttachmentTooLarge
+	}
+
+	return content, resp.Header.Get("Content-Type"), nil
+}
+
+// UploadAttachment attaches a file with the given name and content to an alert in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - id: The identifier of the alert to attach the file to
+//   - idType: The type of the identifier (alert.ALERTID, alert.ALIAS or alert.TINYID)
+//   - fileName: The name of the attachment
+//   - content: The content of the attachment
+//   - user: Display name of the request owner
+//
+// Returns:
+//   - *alert.CreateAlertAttachmentsResult: The result of the upload, including the attachment ID
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) UploadAttachment(ctx context.Context, id string, idType alert.AlertIdentifier, fileName string, content []byte, user string) (*alert.CreateAlertAttachmentsResult, error) {
+	if fileName != filepath.Base(fileName) || fileName == "." || fileName == ".." {
+		return nil, fmt.Errorf("invalid attachment file name '%s', must not contain path separators or be '.' or '..'", fileName)
+	}
+
+	slog.Info("uploading alert attachment", "id", id, "fileName", fileName, "size", len(content), "user", user)
+
+	// The SDK only uploads files from disk, so stage the content in a temporary directory
+	dir, err := os.MkdirTemp("", "mcp-opsgenie-attachment-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory for attachment: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, fileName), content, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write attachment to temporary file: %w", err)
+	}
+
+	createRequest := &alert.CreateAlertAttachmentRequest{
+		IdentifierValue: id,
+		IdentifierType:  idType,
+		FileName:        fileName,
+		FilePath:        dir,
+		User:            user,
+	}
+
+	response, err := a.Client.CreateAlertAttachments(ctx, createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload attachment to alert with ID %s: %w", id, err)
+	}
+
+	slog.Info("uploaded alert attachment", "id", id, "attachmentId", response.Attachment.Id)
+
+	return response, nil
+}
+
+// ListSavedSearches retrieves the IDs and names of all saved alert searches from OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//
+// Returns:
+//   - []SavedSearch: The saved searches
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) ListSavedSearches(ctx context.Context) ([]SavedSearch, error) {
+	slog.Info("fetching saved searches")
+
+	result := &listSavedSearchesResult{}
+	if err := a.opsgenieClient.Exec(ctx, &alert.ListSavedSearchRequest{}, result); err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+
+	slog.Info("fetched saved searches", "count", len(result.SavedSearches))
+
+	return result.SavedSearches, nil
+}
+
+// GetSavedSearch retrieves a saved alert search, including its query, from OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - identifier: The ID or name of the saved search
+//   - identifierType: The type of the identifier (alert.ID or alert.NAME)
+//
+// Returns:
+//   - *alert.GetSavedSearchResult: The saved search details
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) GetSavedSearch(ctx context.Context, identifier string, identifierType alert.SearchIdentifierType) (*alert.GetSavedSearchResult, error) {
+	slog.Info("fetching saved search", "identifier", identifier, "identifierType", identifierType)
+
+	getRequest := &alert.GetSavedSearchRequest{
+		IdentifierValue: identifier,
+		IdentifierType:  identifierType,
+	}
+
+	response, err := a.Client.GetSavedSearch(ctx, getRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved search %s: %w", identifier, err)
+	}
+
+	slog.Info("fetched saved search", "id", response.Id, "name", response.Name)
+
+	return response, nil
+}
+
+// CreateSavedSearch creates a new saved alert search in OpsGenie.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - createRequest: The saved search to create, including its name, query and owner
+//
+// Returns:
+//   - *alert.SavedSearchResult: The ID and name of the created saved search
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) CreateSavedSearch(ctx context.Context, createRequest *alert.CreateSavedSearchRequest) (*alert.SavedSearchResult, error) {
+	slog.Info("creating saved search", "name", createRequest.Name, "query", createRequest.Query)
+
+	response, err := a.Client.CreateSavedSearch(ctx, createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create saved search %s: %w", createRequest.Name, err)
+	}
+
+	slog.Info("created saved search", "id", response.Id, "name", response.Name)
+
+	return response, nil
+}
+
+// DeleteSavedSearch deletes a saved alert search from OpsGenie.
+// Unlike the alert write methods, it returns as soon as OpsGenie has accepted the request
+// and does not wait for the request status.
+//
+// Parameters:
+//   - ctx: Context for request cancellation and timeout control
+//   - identifier: The ID or name of the saved search
+//   - identifierType: The type of the identifier (alert.ID or alert.NAME)
+//
+// Returns:
+//   - *alert.AsyncAlertResult: The accepted request, including its request ID
+//   - error: An error if the API request fails or the context is cancelled
+func (a *AlertClient) DeleteSavedSearch(ctx context.Context, identifier string, identifierType alert.SearchIdentifierType) (*alert.AsyncAlertResult, error) {
+	slog.Info("deleting saved search", "identifier", identifier, "identifierType", identifierType)
+
+	deleteRequest := &alert.DeleteSavedSearchRequest{
+		IdentifierValue: identifier,
+		IdentifierType:  identifierType,
+	}
+
+	response, err := a.Client.DeleteSavedSearch(ctx, deleteRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete saved search %s: %w", identifier, err)
+	}
+
+	slog.Info("deleted saved search", "identifier", identifier, "requestId", response.RequestId)
+
+	return response, nil
+}
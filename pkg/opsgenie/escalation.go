@@ -0,0 +1,51 @@
+// Package opsgenie provides a client for interacting with the OpsGenie API.
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/escalation"
+	"github.com/sirupsen/logrus"
+)
+
+// EscalationClient is a wrapper around the OpsGenie escalation client.
+type EscalationClient struct {
+	*escalation.Client
+}
+
+// NewEscalationClient creates a new EscalationClient instance.
+func NewEscalationClient(apiUrl, envVar string) (*EscalationClient, error) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	config := &client.Config{
+		OpsGenieAPIURL: client.ApiUrl(apiUrl),
+		ApiKey:         os.Getenv(envVar),
+		Logger:         logger,
+	}
+
+	escalationClient, err := escalation.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OpsGenie escalation client: %w", err)
+	}
+
+	e := &EscalationClient{
+		Client: escalationClient,
+	}
+
+	return e, nil
+}
+
+// ListEscalations retrieves all escalation policies from OpsGenie.
+func (c *EscalationClient) ListEscalations(ctx context.Context) ([]escalation.Escalation, error) {
+	result, err := c.Client.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list escalations: %w", err)
+	}
+
+	return result.Escalations, nil
+}
@@ -0,0 +1,488 @@
+package opsgenie
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// QueryFields lists the alert fields that can be used in OpsGenie alert search queries.
+// Reference: https://support.atlassian.com/opsgenie/docs/search-queries-for-alerts/
+var QueryFields = []string{
+	"createdAt",
+	"lastOccurredAt",
+	"snoozedUntil",
+	"alertId",
+	"tinyId",
+	"alias",
+	"count",
+	"message",
+	"description",
+	"source",
+	"entity",
+	"status",
+	"priority",
+	"owner",
+	"acknowledgedBy",
+	"closedBy",
+	"recipients",
+	"isSeen",
+	"acknowledged",
+	"snoozed",
+	"teams",
+	"integration.name",
+	"integration.type",
+	"tag",
+	"actions",
+	"details.key",
+	"details.value",
+}
+
+var (
+	// comparableQueryFields lists the numeric and timestamp fields that support comparison operators.
+	comparableQueryFields = []string{"createdAt", "lastOccurredAt", "snoozedUntil", "count", "tinyId"}
+
+	// nullableQueryFields lists the fields that support null checks.
+	nullableQueryFields = []string{
+		"source", "entity", "tag", "actions", "owner", "teams", "acknowledgedBy", "closedBy",
+		"recipients", "details.key", "details.value", "integration.name", "integration.type",
+	}
+
+	// booleanQueryFields lists the fields whose values are either true or false.
+	booleanQueryFields = []string{"isSeen", "acknowledged", "snoozed"}
+
+	// noWildcardQueryFields lists the fields that do not support wildcards, as they refer to teams or users.
+	noWildcardQueryFields = []string{"teams", "owner", "acknowledgedBy", "closedBy", "recipients"}
+
+	// comparisonValuePattern matches the values accepted by comparison operators:
+	// epoch milliseconds or other numbers, and DD-MM-YYYY dates.
+	comparisonValuePattern = regexp.MustCompile(`^(\d+|\d{2}-\d{2}-\d{4})$`)
+
+	// plainQueryValuePattern matches the values that can be used in alert search queries without quotes.
+	plainQueryValuePattern = regexp.MustCompile(`^[A-Za-z0-9._@-]+$`)
+)
+
+// QueryIssue is a problem found in an alert search query.
+type QueryIssue struct {
+	// Position is the 0-based index of the character of the query where the problem was found.
+	Position int    `json:"position"`
+	Message  string `json:"message"`
+}
+
+// Error formats the issue together with its position.
+func (i QueryIssue) Error() string {
+	return fmt.Sprintf("position %d: %s", i.Position, i.Message)
+}
+
+// ValidateQuery parses an OpsGenie alert search query and reports the problems found in it,
+// such as unknown fields, misplaced wildcards and unbalanced parentheses.
+// An empty query is valid.
+//
+// Parameters:
+//   - query: OpsGenie query string for filtering alerts
+//
+// Returns:
+//   - []QueryIssue: The problems found in the query, empty if the query is valid
+func ValidateQuery(query string) []QueryIssue {
+	tokens, issues := tokenizeQuery(query)
+	if len(issues) > 0 || len(tokens) == 1 {
+		return issues
+	}
+
+	p := &queryParser{tokens: tokens}
+	p.parseOr("")
+	if !p.failed && p.peek().kind != queryTokenEOF {
+		p.fail(p.peek().position, fmt.Sprintf("unexpected '%s'", p.peek().text))
+	}
+
+	return p.issues
+}
+
+// queryTokenKind is the kind of a token of an alert search query.
+type queryTokenKind int
+
+const (
+	queryTokenEOF queryTokenKind = iota
+	queryTokenWord
+	queryTokenString
+	queryTokenLeftParen
+	queryTokenRightParen
+	queryTokenColon
+	queryTokenNotColon
+	queryTokenComparison
+)
+
+// queryToken is a token of an alert search query. Quoted strings are unquoted in text.
+type queryToken struct {
+	kind     queryTokenKind
+	text     string
+	position int
+}
+
+// tokenizeQuery splits an alert search query into tokens, terminated by an EOF token.
+// It reports unterminated quotes and unbalanced parentheses.
+func tokenizeQuery(query string) ([]queryToken, []QueryIssue) {
+	var (
+		tokens     []queryToken
+		issues     []QueryIssue
+		openParens []int
+	)
+
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			openParens = append(openParens, i)
+			tokens = append(tokens, queryToken{kind: queryTokenLeftParen, text: "(", position: i})
+			i++
+		case r == ')':
+			if len(openParens) == 0 {
+				issues = append(issues, QueryIssue{Position: i, Message: "unbalanced ')' without a matching '('"})
+			} else {
+				openParens = openParens[:len(openParens)-1]
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenRightParen, text: ")", position: i})
+			i++
+		case r == ':':
+			tokens = append(tokens, queryToken{kind: queryTokenColon, text: ":", position: i})
+			i++
+		case r == '!' && i+1 < len(runes) && runes[i+1] == ':':
+			tokens = append(tokens, queryToken{kind: queryTokenNotColon, text: "!:", position: i})
+			i += 2
+		case r == '<' || r == '>':
+			text := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				text += "="
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenComparison, text: text, position: i})
+			i += len(text)
+		case r == '"':
+			start := i
+			var value strings.Builder
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				value.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				issues = append(issues, QueryIssue{Position: start, Message: "unterminated quoted string"})
+				return nil, issues
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenString, text: value.String(), position: start})
+			i++
+		default:
+			start := i
+			for i < len(runes) && !isQueryDelimiter(runes, i) {
+				i++
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenWord, text: string(runes[start:i]), position: start})
+		}
+	}
+
+	for _, position := range openParens {
+		issues = append(issues, QueryIssue{Position: position, Message: "unbalanced '(' is never closed"})
+	}
+
+	tokens = append(tokens, queryToken{kind: queryTokenEOF, text: "end of query", position: len(runes)})
+
+	return tokens, issues
+}
+
+// isQueryDelimiter reports whether the rune at index i ends a word.
+func isQueryDelimiter(runes []rune, i int) bool {
+	switch r := runes[i]; {
+	case unicode.IsSpace(r), r == '(', r == ')', r == ':', r == '"', r == '<', r == '>':
+		return true
+	case r == '!':
+		return i+1 < len(runes) && runes[i+1] == ':'
+	default:
+		return false
+	}
+}
+
+// queryParser is a recursive descent parser for alert search queries. It stops at the first
+// syntax error, but keeps collecting issues such as unknown fields until then.
+type queryParser struct {
+	tokens []queryToken
+	next   int
+	issues []QueryIssue
+	failed bool
+}
+
+// peek returns the next token without consuming it.
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.next]
+}
+
+// peekAt returns the token at the given offset from the next token without consuming it.
+func (p *queryParser) peekAt(offset int) queryToken {
+	if p.next+offset >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.next+offset]
+}
+
+// advance consumes and returns the next token. The EOF token is never consumed.
+func (p *queryParser) advance() queryToken {
+	t := p.tokens[p.next]
+	if t.kind != queryTokenEOF {
+		p.next++
+	}
+	return t
+}
+
+// report records an issue without stopping the parser.
+func (p *queryParser) report(position int, message string) {
+	p.issues = append(p.issues, QueryIssue{Position: position, Message: message})
+}
+
+// fail records a syntax error and stops the parser.
+func (p *queryParser) fail(position int, message string) {
+	p.report(position, message)
+	p.failed = true
+}
+
+// isKeyword reports whether a token is the given logical operator.
+func isKeyword(t queryToken, keyword string) bool {
+	return t.kind == queryTokenWord && t.text == keyword
+}
+
+// parseOr parses expressions combined with OR. Inside a field group such as
+// message:(error OR warning), field is the name of the field the values belong to.
+func (p *queryParser) parseOr(field string) {
+	p.parseAnd(field)
+	for !p.failed && isKeyword(p.peek(), "OR") {
+		p.advance()
+		p.parseAnd(field)
+	}
+}
+
+// parseAnd parses expressions combined with AND, or implicitly by juxtaposition.
+func (p *queryParser) parseAnd(field string) {
+	p.parseNot(field)
+	for !p.failed {
+		t := p.peek()
+		switch {
+		case isKeyword(t, "AND"):
+			p.advance()
+			p.parseNot(field)
+		case isKeyword(t, "OR"), t.kind == queryTokenEOF, t.kind == queryTokenRightParen:
+			return
+		default:
+			p.parseNot(field)
+		}
+	}
+}
+
+// parseNot parses an expression preceded by any number of NOT operators.
+func (p *queryParser) parseNot(field string) {
+	for isKeyword(p.peek(), "NOT") {
+		p.advance()
+	}
+	p.parsePrimary(field)
+}
+
+// parsePrimary parses a parenthesized expression, a field term or a free text term.
+func (p *queryParser) parsePrimary(field string) {
+	if p.failed {
+		return
+	}
+
+	t := p.peek()
+	switch {
+	case t.kind == queryTokenLeftParen:
+		p.advance()
+		if p.peek().kind == queryTokenRightParen {
+			p.fail(t.position, "empty parentheses")
+			return
+		}
+		p.parseOr(field)
+		if !p.failed {
+			if p.peek().kind != queryTokenRightParen {
+				p.fail(p.peek().position, fmt.Sprintf("expected ')' but found '%s'", p.peek().text))
+				return
+			}
+			p.advance()
+		}
+	case isKeyword(t, "AND"), isKeyword(t, "OR"):
+		p.fail(t.position, fmt.Sprintf("operator '%s' is missing its left operand", t.text))
+	case t.kind == queryTokenEOF:
+		p.fail(t.position, "expected a search term but the query ended")
+	case field != "":
+		p.parseValue(field)
+	case t.kind == queryTokenWord:
+		p.parseTerm()
+	case t.kind == queryTokenString:
+		p.advance()
+	default:
+		p.fail(t.position, fmt.Sprintf("unexpected '%s'", t.text))
+	}
+}
+
+// parseTerm parses a term starting with a word, which is either a field condition
+// (field:value, field:(values), field > value, null checks) or a free text term.
+func (p *queryParser) parseTerm() {
+	t := p.advance()
+	next := p.peek()
+
+	switch {
+	case next.kind == queryTokenColon:
+		p.advance()
+		p.checkField(t)
+		if p.peek().kind == queryTokenLeftParen {
+			p.parsePrimary(t.text)
+			return
+		}
+		p.parseValue(t.text)
+	case next.kind == queryTokenNotColon:
+		p.advance()
+		p.checkField(t)
+		value := p.advance()
+		if !strings.EqualFold(value.text, "null") {
+			p.fail(value.position, fmt.Sprintf("'!:' must be followed by null, found '%s'", value.text))
+			return
+		}
+		p.checkNullable(t)
+	case next.kind == queryTokenComparison:
+		p.advance()
+		p.checkField(t)
+		value := p.advance()
+		if value.kind != queryTokenWord && value.kind != queryTokenString {
+			p.fail(value.position, fmt.Sprintf("expected a value after '%s' but found '%s'", next.text, value.text))
+			return
+		}
+		if isKnownQueryField(t.text) && !slices.Contains(comparableQueryFields, t.text) {
+			p.report(next.position, fmt.Sprintf("comparison operator '%s' is not supported for field '%s', only for %s", next.text, t.text, strings.Join(comparableQueryFields, ", ")))
+		} else if !comparisonValuePattern.MatchString(value.text) {
+			p.report(value.position, fmt.Sprintf("invalid value '%s' for '%s', must be a number such as epoch milliseconds or a DD-MM-YYYY date", value.text, next.text))
+		}
+	case next.kind == queryTokenWord && strings.EqualFold(next.text, "is") && p.isNullCheck():
+		p.advance()
+		if strings.EqualFold(p.peek().text, "not") {
+			p.advance()
+		}
+		p.advance()
+		p.checkField(t)
+		p.checkNullable(t)
+	default:
+		p.checkWildcard(t, "")
+	}
+}
+
+// isNullCheck reports whether the upcoming tokens, starting with 'is', form "is null" or "is not null".
+func (p *queryParser) isNullCheck() bool {
+	if strings.EqualFold(p.peekAt(1).text, "null") {
+		return true
+	}
+	return strings.EqualFold(p.peekAt(1).text, "not") && strings.EqualFold(p.peekAt(2).text, "null")
+}
+
+// parseValue parses the value of a field condition.
+func (p *queryParser) parseValue(field string) {
+	t := p.peek()
+	switch t.kind {
+	case queryTokenWord:
+		if isKeyword(t, "AND") || isKeyword(t, "OR") || isKeyword(t, "NOT") {
+			p.fail(t.position, fmt.Sprintf("expected a value for field '%s' but found operator '%s'", field, t.text))
+			return
+		}
+		p.advance()
+		if strings.EqualFold(t.text, "null") {
+			p.checkNullable(queryToken{text: field, position: t.position})
+			return
+		}
+		p.checkWildcard(t, field)
+		p.checkFieldValue(t, field)
+	case queryTokenString:
+		p.advance()
+		p.checkFieldValue(t, field)
+	default:
+		p.fail(t.position, fmt.Sprintf("expected a value for field '%s' but found '%s'", field, t.text))
+	}
+}
+
+// checkField reports unknown fields, suggesting the correct spelling for case mismatches.
+func (p *queryParser) checkField(t queryToken) {
+	if isKnownQueryField(t.text) {
+		return
+	}
+
+	for _, field := range QueryFields {
+		if strings.EqualFold(field, t.text) {
+			p.report(t.position, fmt.Sprintf("unknown field '%s', did you mean '%s'?", t.text, field))
+			return
+		}
+	}
+
+	p.report(t.position, fmt.Sprintf("unknown field '%s'", t.text))
+}
+
+// checkNullable reports null checks on fields that do not support them.
+func (p *queryParser) checkNullable(field queryToken) {
+	if isKnownQueryField(field.text) && !slices.Contains(nullableQueryFields, field.text) {
+		p.report(field.position, fmt.Sprintf("null checks are not supported for field '%s', only for %s", field.text, strings.Join(nullableQueryFields, ", ")))
+	}
+}
+
+// checkWildcard reports wildcards that are not at the end of a word, or used with fields that do not support them.
+func (p *queryParser) checkWildcard(t queryToken, field string) {
+	runes := []rune(t.text)
+	for i, r := range runes {
+		if r != '*' {
+			continue
+		}
+		if i == 0 || i != len(runes)-1 {
+			p.report(t.position+i, fmt.Sprintf("misplaced wildcard in '%s', wildcards are only supported at the end of a word", t.text))
+			return
+		}
+		if slices.Contains(noWildcardQueryFields, field) {
+			p.report(t.position+i, fmt.Sprintf("wildcards are not supported for field '%s', use the full name", field))
+		}
+	}
+}
+
+// checkFieldValue reports values that are not valid for fields with a fixed set of values.
+func (p *queryParser) checkFieldValue(t queryToken, field string) {
+	value := strings.ToLower(t.text)
+	if strings.HasSuffix(value, "*") {
+		return
+	}
+
+	switch {
+	case field == "status" && value != "open" && value != "closed":
+		p.report(t.position, fmt.Sprintf("invalid value '%s' for field 'status', must be open or closed", t.text))
+	case slices.Contains(booleanQueryFields, field) && value != "true" && value != "false":
+		p.report(t.position, fmt.Sprintf("invalid value '%s' for field '%s', must be true or false", t.text, field))
+	}
+}
+
+// QuoteQueryValue returns a value for use in an alert search query. Only values consisting of
+// letters, digits, '.', '_', '@' and '-' are left as they are; all other values, including the
+// logical operators and null, are quoted, escaping quotes and backslashes.
+//
+// Parameters:
+//   - value: The value to quote
+//
+// Returns:
+//   - string: The value, quoted if necessary
+func QuoteQueryValue(value string) string {
+	needsQuotes := !plainQueryValuePattern.MatchString(value) ||
+		slices.Contains([]string{"AND", "OR", "NOT"}, value) ||
+		strings.EqualFold(value, "null")
+	if !needsQuotes {
+		return value
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// isKnownQueryField reports whether a field can be used in alert search queries.
+func isKnownQueryField(field string) bool {
+	return slices.Contains(QueryFields, field)
+}
@@ -0,0 +1,370 @@
+package opsgenie
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateQueryAcceptsValidQueries(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+	}{
+		// Field reference of the 'list_alerts' query description
+		{name: "createdAt timestamp", query: "createdAt:1470394841148"},
+		{name: "createdAt date", query: "createdAt:15-05-2020"},
+		{name: "lastOccurredAt", query: "lastOccurredAt:1470394841148"},
+		{name: "snoozedUntil", query: "snoozedUntil:1470394841148"},
+		{name: "alertId", query: "alertId:b9a2fb13-1b76-4b41-be28-eed2c61978fa"},
+		{name: "tinyId", query: "tinyId:28"},
+		{name: "alias", query: "alias:host_down"},
+		{name: "count", query: "count:5"},
+		{name: "message", query: `message:"Server apollo average"`},
+		{name: "description", query: `description:"Monitoring tool is reporting..."`},
+		{name: "source", query: "source:john.smith@opsgenie.com"},
+		{name: "entity", query: "entity:entity1"},
+		{name: "status", query: "status:open"},
+		{name: "priority", query: "priority:P1"},
+		{name: "owner", query: "owner:john.smith@opsgenie.com"},
+		{name: "acknowledgedBy", query: "acknowledgedBy:john.smith@opsgenie.com"},
+		{name: "closedBy", query: "closedBy:john.smith@opsgenie.com"},
+		{name: "recipients", query: "recipients:john.smith@opsgenie.com"},
+		{name: "isSeen", query: "isSeen:true"},
+		{name: "acknowledged", query: "acknowledged:true"},
+		{name: "snoozed", query: "snoozed:false"},
+		{name: "teams", query: "teams:team1"},
+		{name: "integration.name", query: `integration.name:"API Integration"`},
+		{name: "integration.type", query: "integration.type:API"},
+		{name: "tag", query: "tag:EC2"},
+		{name: "actions", query: "actions:start"},
+		{name: "details.key", query: "details.key:Impact"},
+		{name: "details.value", query: "details.value:External"},
+
+		// Comparison operators
+		{name: "greater than", query: "count > 5"},
+		{name: "less than", query: "count < 10"},
+		{name: "greater than or equal", query: "count >= 3"},
+		{name: "less than or equal", query: "count <= 4"},
+		{name: "less than timestamp", query: "lastOccurredAt < 1470394841148"},
+		{name: "comparison without spaces", query: "createdAt>=1470394841148"},
+		{name: "comparison with date", query: "createdAt > 15-05-2020"},
+
+		// Logical operators
+		{name: "AND in field group", query: "message:(error AND critical)"},
+		{name: "OR in field group", query: "message:(error OR warning)"},
+		{name: "NOT", query: "NOT status:closed"},
+		{name: "parentheses for grouping", query: "(message:error OR description:critical) AND status:open"},
+
+		// Complex query examples
+		{name: "multiple conditions", query: "message:error AND count >= 3"},
+		{name: "grouped conditions", query: "(message:error OR message:warning) AND status:open"},
+		{name: "status with count", query: "status:open AND (count >= 3 OR entity:database)"},
+		{name: "negation", query: "NOT message:test AND status:open"},
+
+		// Wildcards
+		{name: "wildcard", query: "message:error*"},
+		{name: "wildcard on message", query: "message:database*"},
+		{name: "wildcard on source", query: "source:app*"},
+
+		// Null value queries
+		{name: "colon null", query: "owner:null"},
+		{name: "is null", query: "teams is null"},
+		{name: "is not null", query: "details.key is not null"},
+		{name: "not colon null", query: "tag !: null"},
+		{name: "not colon null without spaces", query: "tag!:null"},
+
+		// Common query patterns
+		{name: "open alerts", query: "status:open"},
+		{name: "high-priority alerts", query: "message:(critical OR high OR urgent)"},
+		{name: "unassigned alerts", query: "owner:null AND status:open"},
+		{name: "recent alerts", query: "createdAt > 1640995200000"},
+		{name: "alerts by team", query: "teams:infrastructure"},
+		{name: "alerts with tags", query: "tag !: null"},
+		{name: "alerts without acknowledgment", query: "acknowledgedBy:null AND status:open"},
+		{name: "multi-word value", query: `message:"database connection error"`},
+
+		// Other valid queries
+		{name: "empty query", query: ""},
+		{name: "whitespace only", query: "   "},
+		{name: "free text", query: "database"},
+		{name: "quoted free text", query: `"disk full"`},
+		{name: "implicit AND", query: "status:open priority:P1"},
+		{name: "double negation", query: "NOT NOT status:open"},
+		{name: "negated group", query: "NOT (status:closed OR priority:P5)"},
+		{name: "escaped quote", query: `message:"say \"hi\""`},
+		{name: "escaped backslash", query: `message:"C:\\temp"`},
+		{name: "nested groups", query: "((status:open) AND (priority:P1 OR (priority:P2 AND tag:critical)))"},
+		{name: "time window clauses", query: "(status:open) AND createdAt >= 1714521600000 AND createdAt < 1714608000000"},
+		{name: "built query", query: `status:open AND priority:(P1 OR P2) AND teams:"SRE team" AND tag:(a AND "b:c") AND source:prom* AND details.key:cluster AND details.value:"prod eu"`},
+		{name: "upper case status value", query: "status:OPEN"},
+		{name: "boolean field with wildcard", query: "acknowledged:tr*"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if issues := ValidateQuery(tc.query); len(issues) > 0 {
+				t.Errorf("expected query %q to be valid, got issues %v", tc.query, issues)
+			}
+		})
+	}
+}
+
+func TestValidateQueryReportsIssues(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		position int
+		message  string
+	}{
+		{
+			name:     "unknown field",
+			query:    "status:open AND foo:bar",
+			position: 16,
+			message:  "unknown field 'foo'",
+		},
+		{
+			name:     "unknown field with different case",
+			query:    "Status:open",
+			position: 0,
+			message:  "did you mean 'status'?",
+		},
+		{
+			name:     "unknown field in comparison",
+			query:    "created > 5",
+			position: 0,
+			message:  "unknown field 'created'",
+		},
+		{
+			name:     "wildcard at the start of a value",
+			query:    "message:*error",
+			position: 8,
+			message:  "misplaced wildcard",
+		},
+		{
+			name:     "wildcard in the middle of a value",
+			query:    "message:err*or",
+			position: 11,
+			message:  "misplaced wildcard",
+		},
+		{
+			name:     "wildcard in free text",
+			query:    "*disk",
+			position: 0,
+			message:  "misplaced wildcard",
+		},
+		{
+			name:     "wildcard on teams",
+			query:    "teams:infra*",
+			position: 11,
+			message:  "wildcards are not supported for field 'teams'",
+		},
+		{
+			name:     "wildcard on owner in field group",
+			query:    "owner:(john* OR jane)",
+			position: 11,
+			message:  "wildcards are not supported for field 'owner'",
+		},
+		{
+			name:     "unbalanced opening parenthesis",
+			query:    "(status:open AND priority:P1",
+			position: 0,
+			message:  "unbalanced '('",
+		},
+		{
+			name:     "unbalanced closing parenthesis",
+			query:    "status:open)",
+			position: 11,
+			message:  "unbalanced ')'",
+		},
+		{
+			name:     "empty parentheses",
+			query:    "status:open AND ()",
+			position: 16,
+			message:  "empty parentheses",
+		},
+		{
+			name:     "dangling AND",
+			query:    "status:open AND",
+			position: 15,
+			message:  "query ended",
+		},
+		{
+			name:     "dangling OR",
+			query:    "status:open OR",
+			position: 14,
+			message:  "query ended",
+		},
+		{
+			name:     "leading AND",
+			query:    "AND status:open",
+			position: 0,
+			message:  "operator 'AND' is missing its left operand",
+		},
+		{
+			name:     "leading OR in group",
+			query:    "status:open AND (OR priority:P1)",
+			position: 17,
+			message:  "operator 'OR' is missing its left operand",
+		},
+		{
+			name:     "consecutive operators",
+			query:    "status:open AND OR priority:P1",
+			position: 16,
+			message:  "operator 'OR' is missing its left operand",
+		},
+		{
+			name:     "dangling NOT",
+			query:    "status:open AND NOT",
+			position: 19,
+			message:  "query ended",
+		},
+		{
+			name:     "dangling double NOT",
+			query:    "NOT NOT",
+			position: 7,
+			message:  "query ended",
+		},
+		{
+			name:     "not colon without null",
+			query:    "tag !: critical",
+			position: 7,
+			message:  "'!:' must be followed by null",
+		},
+		{
+			name:     "missing value",
+			query:    "status: AND priority:P1",
+			position: 8,
+			message:  "expected a value for field 'status'",
+		},
+		{
+			name:     "unterminated quote",
+			query:    `message:"disk full`,
+			position: 8,
+			message:  "unterminated quoted string",
+		},
+		{
+			name:     "comparison on unsupported field",
+			query:    "priority > P1",
+			position: 9,
+			message:  "comparison operator '>' is not supported for field 'priority'",
+		},
+		{
+			name:     "comparison with invalid value",
+			query:    "createdAt > yesterday",
+			position: 12,
+			message:  "invalid value 'yesterday'",
+		},
+		{
+			name:     "invalid status",
+			query:    "status:pending",
+			position: 7,
+			message:  "invalid value 'pending' for field 'status'",
+		},
+		{
+			name:     "invalid boolean",
+			query:    "acknowledged:yes",
+			position: 13,
+			message:  "must be true or false",
+		},
+		{
+			name:     "null check on unsupported field",
+			query:    "message is null",
+			position: 0,
+			message:  "null checks are not supported for field 'message'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			issues := ValidateQuery(tc.query)
+			if len(issues) == 0 {
+				t.Fatalf("expected an issue for query %q, got none", tc.query)
+			}
+
+			issue := issues[0]
+			if issue.Position != tc.position {
+				t.Errorf("expected issue at position %d, got %d (%s)", tc.position, issue.Position, issue.Message)
+			}
+			if !strings.Contains(issue.Message, tc.message) {
+				t.Errorf("expected issue message to contain %q, got %q", tc.message, issue.Message)
+			}
+		})
+	}
+}
+
+func TestValidateQueryReportsAllFieldIssues(t *testing.T) {
+	issues := ValidateQuery("foo:a AND bar:b")
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, got %v", issues)
+	}
+	if issues[0].Position != 0 || issues[1].Position != 10 {
+		t.Errorf("expected issues at positions 0 and 10, got %v", issues)
+	}
+}
+
+func TestQueryIssueError(t *testing.T) {
+	issue := QueryIssue{Position: 4, Message: "unknown field 'foo'"}
+	if expected := "position 4: unknown field 'foo'"; issue.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, issue.Error())
+	}
+}
+
+func TestQuoteQueryValue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "plain word", value: "prometheus", expected: "prometheus"},
+		{name: "allowed punctuation", value: "john.smith@example.com", expected: "john.smith@example.com"},
+		{name: "dashes and underscores", value: "cluster-a_1", expected: "cluster-a_1"},
+		{name: "priority", value: "P1", expected: "P1"},
+		{name: "empty value", value: "", expected: `""`},
+		{name: "multi-word value", value: "SRE team", expected: `"SRE team"`},
+		{name: "tab", value: "a\tb", expected: "\"a\tb\""},
+		{name: "embedded quotes", value: `say "hi"`, expected: `"say \"hi\""`},
+		{name: "backslash", value: `C:\temp`, expected: `"C:\\temp"`},
+		{name: "trailing backslash", value: `a\`, expected: `"a\\"`},
+		{name: "colon", value: "b:c", expected: `"b:c"`},
+		{name: "parentheses", value: "(x)", expected: `"(x)"`},
+		{name: "wildcard", value: "a*", expected: `"a*"`},
+		{name: "comparison", value: "a>b", expected: `"a>b"`},
+		{name: "not colon", value: "a!b", expected: `"a!b"`},
+		{name: "slash", value: "ns/pod", expected: `"ns/pod"`},
+		{name: "brackets", value: "[x]", expected: `"[x]"`},
+		{name: "braces", value: "{x}", expected: `"{x}"`},
+		{name: "double pipe", value: "a||b", expected: `"a||b"`},
+		{name: "double ampersand", value: "a&&b", expected: `"a&&b"`},
+		{name: "tilde", value: "a~b", expected: `"a~b"`},
+		{name: "caret", value: "a^b", expected: `"a^b"`},
+		{name: "question mark", value: "a?", expected: `"a?"`},
+		{name: "plus", value: "a+b", expected: `"a+b"`},
+		{name: "equals", value: "a=b", expected: `"a=b"`},
+		{name: "non-ASCII letters", value: "müller", expected: `"müller"`},
+		{name: "keyword AND", value: "AND", expected: `"AND"`},
+		{name: "keyword OR", value: "OR", expected: `"OR"`},
+		{name: "keyword NOT", value: "NOT", expected: `"NOT"`},
+		{name: "lower case keyword", value: "and", expected: "and"},
+		{name: "null", value: "null", expected: `"null"`},
+		{name: "upper case null", value: "NULL", expected: `"NULL"`},
+		{name: "word containing null", value: "nullable", expected: "nullable"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := QuoteQueryValue(tc.value)
+			if actual != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, actual)
+			}
+
+			// The quoted value must round-trip through the query tokenizer unchanged
+			tokens, issues := tokenizeQuery("message:" + actual)
+			if len(issues) > 0 {
+				t.Fatalf("unexpected issues for %s: %v", actual, issues)
+			}
+			if len(tokens) != 4 || tokens[2].text != tc.value {
+				t.Errorf("expected the query value to tokenize to %q, got %+v", tc.value, tokens)
+			}
+		})
+	}
+}